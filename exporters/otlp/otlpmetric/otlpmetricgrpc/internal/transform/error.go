@@ -28,6 +28,7 @@ import (
 var (
 	errUnknownAggregation = errors.New("unknown aggregation")
 	errUnknownTemporality = errors.New("unknown temporality")
+	errDeltaSummary       = errors.New("delta summary")
 )
 
 type errMetric struct {
@@ -109,6 +109,10 @@ func metric(m metricdata.Metrics) (*mpb.Metric, error) {
 		out.Data, err = ExponentialHistogram(a)
 	case metricdata.ExponentialHistogram[float64]:
 		out.Data, err = ExponentialHistogram(a)
+	case metricdata.Summary[int64]:
+		out.Data, err = Summary(a)
+	case metricdata.Summary[float64]:
+		out.Data, err = Summary(a)
 	default:
 		return out, fmt.Errorf("%w: %T", errUnknownAggregation, a)
 	}
@@ -262,6 +266,55 @@ func ExponentialHistogramDataPointBuckets(bucket metricdata.ExponentialBucket) *
 	}
 }
 
+// Summary returns an OTLP Metric_Summary generated from s. OTLP summaries
+// do not carry a temporality and are always cumulative. An error is returned
+// if the temporality of s is not cumulative, a delta summary would be
+// interpreted as cumulative by its receivers.
+func Summary[N int64 | float64](s metricdata.Summary[N]) (*mpb.Metric_Summary, error) {
+	switch s.Temporality {
+	case metricdata.CumulativeTemporality:
+	case metricdata.DeltaTemporality:
+		return nil, errDeltaSummary
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownTemporality, s.Temporality)
+	}
+	return &mpb.Metric_Summary{
+		Summary: &mpb.Summary{
+			DataPoints: SummaryDataPoints(s.DataPoints),
+		},
+	}, nil
+}
+
+// SummaryDataPoints returns a slice of OTLP SummaryDataPoint generated from
+// dPts. The minimum and maximum values, when defined, are exported as the 0
+// and 1 quantiles respectively.
+func SummaryDataPoints[N int64 | float64](dPts []metricdata.SummaryDataPoint[N]) []*mpb.SummaryDataPoint {
+	out := make([]*mpb.SummaryDataPoint, 0, len(dPts))
+	for _, dPt := range dPts {
+		sdp := &mpb.SummaryDataPoint{
+			Attributes:        AttrIter(dPt.Attributes.Iter()),
+			StartTimeUnixNano: timeUnixNano(dPt.StartTime),
+			TimeUnixNano:      timeUnixNano(dPt.Time),
+			Count:             dPt.Count,
+			Sum:               float64(dPt.Sum),
+		}
+		if v, ok := dPt.Min.Value(); ok {
+			sdp.QuantileValues = append(sdp.QuantileValues, &mpb.SummaryDataPoint_ValueAtQuantile{
+				Quantile: 0,
+				Value:    float64(v),
+			})
+		}
+		if v, ok := dPt.Max.Value(); ok {
+			sdp.QuantileValues = append(sdp.QuantileValues, &mpb.SummaryDataPoint_ValueAtQuantile{
+				Quantile: 1,
+				Value:    float64(v),
+			})
+		}
+		out = append(out, sdp)
+	}
+	return out
+}
+
 // Temporality returns an OTLP AggregationTemporality generated from t. If t
 // is unknown, an error is returned along with the invalid
 // AggregationTemporality_AGGREGATION_TEMPORALITY_UNSPECIFIED.
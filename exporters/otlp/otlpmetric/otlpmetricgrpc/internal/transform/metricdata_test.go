@@ -564,6 +564,63 @@ var (
 	}
 )
 
+func TestSummary(t *testing.T) {
+	otelSDP := []metricdata.SummaryDataPoint[int64]{
+		{
+			Attributes: alice,
+			StartTime:  start,
+			Time:       end,
+			Count:      3,
+			Min:        metricdata.NewExtrema[int64](2),
+			Max:        metricdata.NewExtrema[int64](4),
+			Sum:        9,
+		},
+		{
+			Attributes: bob,
+			StartTime:  start,
+			Time:       end,
+			Count:      0,
+		},
+	}
+	pbSDP := []*mpb.SummaryDataPoint{
+		{
+			Attributes:        []*cpb.KeyValue{pbAlice},
+			StartTimeUnixNano: uint64(start.UnixNano()),
+			TimeUnixNano:      uint64(end.UnixNano()),
+			Count:             3,
+			Sum:               9,
+			QuantileValues: []*mpb.SummaryDataPoint_ValueAtQuantile{
+				{Quantile: 0, Value: 2},
+				{Quantile: 1, Value: 4},
+			},
+		},
+		{
+			Attributes:        []*cpb.KeyValue{pbBob},
+			StartTimeUnixNano: uint64(start.UnixNano()),
+			TimeUnixNano:      uint64(end.UnixNano()),
+		},
+	}
+	require.Equal(t, pbSDP, SummaryDataPoints(otelSDP))
+
+	got, err := metric(metricdata.Metrics{
+		Name: "summary",
+		Data: metricdata.Summary[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  otelSDP,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &mpb.Metric_Summary{Summary: &mpb.Summary{DataPoints: pbSDP}}, got.Data)
+
+	_, err = Summary(metricdata.Summary[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  otelSDP,
+	})
+	assert.ErrorIs(t, err, errDeltaSummary)
+	_, err = Summary(metricdata.Summary[float64]{})
+	assert.ErrorIs(t, err, errUnknownTemporality)
+}
+
 func TestTransformations(t *testing.T) {
 	// Run tests from the "bottom-up" of the metricdata data-types and halt
 	// when a failure occurs to ensure the clearest failure message (as
@@ -49,6 +49,7 @@ var (
 	scopeInfoKeys = [2]string{"otel_scope_name", "otel_scope_version"}
 
 	errScopeInvalid = errors.New("invalid scope")
+	errDeltaSummary = errors.New("delta summaries are not supported")
 )
 
 // Exporter is a Prometheus Exporter that embeds the OTel metric.Reader
@@ -219,6 +220,10 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 				addHistogramMetric(ch, v, m, keys, values, name)
 			case metricdata.Histogram[float64]:
 				addHistogramMetric(ch, v, m, keys, values, name)
+			case metricdata.Summary[int64]:
+				addSummaryMetric(ch, v, m, keys, values, name)
+			case metricdata.Summary[float64]:
+				addSummaryMetric(ch, v, m, keys, values, name)
 			case metricdata.Sum[int64]:
 				addSumMetric(ch, v, m, keys, values, name)
 			case metricdata.Sum[float64]:
@@ -254,6 +259,34 @@ func addHistogramMetric[N int64 | float64](ch chan<- prometheus.Metric, histogra
 	}
 }
 
+func addSummaryMetric[N int64 | float64](ch chan<- prometheus.Metric, summary metricdata.Summary[N], m metricdata.Metrics, ks, vs [2]string, name string) {
+	if summary.Temporality == metricdata.DeltaTemporality {
+		// Prometheus summaries are cumulative, the count and sum of a delta
+		// summary would be exported as if they never increased.
+		otel.Handle(fmt.Errorf("%w: %s", errDeltaSummary, name))
+		return
+	}
+
+	for _, dp := range summary.DataPoints {
+		keys, values := getAttrs(dp.Attributes, ks, vs)
+
+		desc := prometheus.NewDesc(name, m.Description, keys, nil)
+		quantiles := make(map[float64]float64, 2)
+		if v, ok := dp.Min.Value(); ok {
+			quantiles[0] = float64(v)
+		}
+		if v, ok := dp.Max.Value(); ok {
+			quantiles[1] = float64(v)
+		}
+		m, err := prometheus.NewConstSummary(desc, dp.Count, float64(dp.Sum), quantiles, values...)
+		if err != nil {
+			otel.Handle(err)
+			continue
+		}
+		ch <- m
+	}
+}
+
 func addSumMetric[N int64 | float64](ch chan<- prometheus.Metric, sum metricdata.Sum[N], m metricdata.Metrics, ks, vs [2]string, name string) {
 	valueType := prometheus.CounterValue
 	if !sum.IsMonotonic {
@@ -454,6 +487,8 @@ func (c *collector) metricType(m metricdata.Metrics) *dto.MetricType {
 	switch v := m.Data.(type) {
 	case metricdata.Histogram[int64], metricdata.Histogram[float64]:
 		return dto.MetricType_HISTOGRAM.Enum()
+	case metricdata.Summary[int64], metricdata.Summary[float64]:
+		return dto.MetricType_SUMMARY.Enum()
 	case metricdata.Sum[float64]:
 		if v.IsMonotonic {
 			return dto.MetricType_COUNTER.Enum()
@@ -30,6 +30,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	otelmetric "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 )
@@ -198,6 +199,26 @@ func TestPrometheusExporter(t *testing.T) {
 				histogram.Record(ctx, 105, opt)
 			},
 		},
+		{
+			name:         "summary",
+			expectedFile: "testdata/summary.txt",
+			recordMetrics: func(ctx context.Context, meter otelmetric.Meter) {
+				opt := otelmetric.WithAttributes(
+					attribute.Key("A").String("B"),
+					attribute.Key("C").String("D"),
+				)
+				histogram, err := meter.Float64Histogram(
+					"summary_baz",
+					otelmetric.WithDescription("a very nice summary"),
+					otelmetric.WithUnit("By"),
+				)
+				require.NoError(t, err)
+				histogram.Record(ctx, 23, opt)
+				histogram.Record(ctx, 7, opt)
+				histogram.Record(ctx, 101, opt)
+				histogram.Record(ctx, 105, opt)
+			},
+		},
 		{
 			name:         "sanitized attributes to labels",
 			expectedFile: "testdata/sanitized_labels.txt",
@@ -402,6 +423,10 @@ func TestPrometheusExporter(t *testing.T) {
 						Boundaries: []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 1000},
 					}},
 				)),
+				metric.WithView(metric.NewView(
+					metric.Instrument{Name: "summary_*"},
+					metric.Stream{Aggregation: metric.AggregationSummary{}},
+				)),
 			)
 			meter := provider.Meter(
 				"testmeter",
@@ -791,6 +816,39 @@ func TestCollectorConcurrentSafe(t *testing.T) {
 	wg.Wait()
 }
 
+func TestDeltaSummary(t *testing.T) {
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+
+	var errs []error
+	eh := otel.ErrorHandlerFunc(func(e error) { errs = append(errs, e) })
+	otel.SetErrorHandler(eh)
+
+	registry := prometheus.NewRegistry()
+	exporter, err := New(WithRegisterer(registry), WithoutTargetInfo(), WithoutScopeInfo())
+	require.NoError(t, err)
+	provider := metric.NewMeterProvider(
+		metric.WithReader(exporter),
+		metric.WithView(metric.NewView(
+			metric.Instrument{Name: "summary"},
+			metric.Stream{
+				Aggregation: metric.AggregationSummary{},
+				Temporality: metricdata.DeltaTemporality,
+			},
+		)),
+	)
+	histogram, err := provider.Meter("TestDeltaSummary").Float64Histogram("summary")
+	require.NoError(t, err)
+	histogram.Record(context.Background(), 1)
+
+	got, err := registry.Gather()
+	require.NoError(t, err)
+	assert.Len(t, got, 0, "delta summary exported")
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], errDeltaSummary)
+}
+
 func TestIncompatibleMeterName(t *testing.T) {
 	defer func(orig otel.ErrorHandler) {
 		otel.SetErrorHandler(orig)
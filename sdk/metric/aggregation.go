@@ -88,6 +88,21 @@ func (l AggregationLastValue) copy() Aggregation { return l }
 // nil.
 func (AggregationLastValue) err() error { return nil }
 
+// AggregationSummary is an Aggregation that summarizes a set of measurements
+// as their count, sum, minimum, and maximum. It is a lightweight alternative
+// to the histogram aggregations for high-frequency instruments, it does not
+// record the distribution of measurements.
+type AggregationSummary struct{} // AggregationSummary has no parameters.
+
+var _ Aggregation = AggregationSummary{}
+
+// copy returns a deep copy of s.
+func (s AggregationSummary) copy() Aggregation { return s }
+
+// err returns an error for any misconfiguration. A summary aggregation has no
+// parameters and cannot be misconfigured, therefore this always returns nil.
+func (AggregationSummary) err() error { return nil }
+
 // AggregationExplicitBucketHistogram is an Aggregation that summarizes a set of
 // measurements as an histogram with explicitly defined buckets.
 type AggregationExplicitBucketHistogram struct {
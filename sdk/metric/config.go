@@ -24,9 +24,10 @@ import (
 
 // config contains configuration options for a MeterProvider.
 type config struct {
-	res     *resource.Resource
-	readers []Reader
-	views   []View
+	res              *resource.Resource
+	readers          []Reader
+	views            []View
+	cardinalityLimit int
 }
 
 // readerSignals returns a force-flush and shutdown function for a
@@ -42,7 +43,7 @@ func (c config) readerSignals() (forceFlush, shutdown func(context.Context) erro
 		}
 	}
 
-	return unify(fFuncs), unifyShutdown(sFuncs)
+	return unifyConcurrent(fFuncs), unifyShutdown(sFuncs)
 }
 
 // unify unifies calling all of funcs into a single function call. All errors
@@ -60,6 +61,33 @@ func unify(funcs []func(context.Context) error) func(context.Context) error {
 	}
 }
 
+// unifyConcurrent unifies calling all of funcs concurrently into a single
+// function call that returns once all calls have returned. All errors
+// returned from calls to funcs will be unify into a single error return
+// value.
+func unifyConcurrent(funcs []func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		results := make([]error, len(funcs))
+		var wg sync.WaitGroup
+		for i, f := range funcs {
+			wg.Add(1)
+			go func(i int, f func(context.Context) error) {
+				defer wg.Done()
+				results[i] = f(ctx)
+			}(i, f)
+		}
+		wg.Wait()
+
+		var errs []error
+		for _, err := range results {
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return unifyErrors(errs)
+	}
+}
+
 // unifyErrors combines multiple errors into a single error.
 func unifyErrors(errs []error) error {
 	switch len(errs) {
@@ -139,10 +167,32 @@ func WithReader(r Reader) Option {
 // used multiple times.
 //
 // By default, if this option is not used, the MeterProvider will use the
-// default view.
+// default view. The views can be replaced after the MeterProvider is created
+// with its UpdateViews method.
 func WithView(views ...View) Option {
 	return optionFunc(func(cfg config) config {
 		cfg.views = append(cfg.views, views...)
 		return cfg
 	})
 }
+
+// WithCardinalityLimit sets the maximum number of distinct attribute sets
+// each instrument of a MeterProvider aggregates during a collection cycle.
+// Measurements with new attribute sets beyond the limit are aggregated into a
+// single overflow attribute set, otel.metric.overflow=true, and a warning
+// with the number of these measurements is logged with the global OTel
+// logger when the instrument is collected. The total number of these
+// measurements is returned by the CardinalityOverflows method of the
+// MeterProvider.
+//
+// The limit can be overridden for individual instruments with the
+// AggregationLimit of a Stream returned from a View.
+//
+// By default, if this option is not used or limit is less than or equal to
+// zero, the number of attribute sets is not limited.
+func WithCardinalityLimit(limit int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.cardinalityLimit = limit
+		return cfg
+	})
+}
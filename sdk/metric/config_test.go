@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -111,6 +112,32 @@ func TestConfigReaderSignalsForwardedErrors(t *testing.T) {
 	assert.ErrorIs(t, s(ctx), ErrReaderShutdown)
 }
 
+func TestConfigReaderSignalsForceFlushConcurrent(t *testing.T) {
+	// Each reader only returns once the other one has been called, which
+	// only happens if they are flushed concurrently.
+	called := [2]chan struct{}{make(chan struct{}), make(chan struct{})}
+	newReader := func(self, other int) *reader {
+		return &reader{
+			forceFlushFunc: func(ctx context.Context) error {
+				close(called[self])
+				select {
+				case <-called[other]:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			},
+			shutdownFunc: func(ctx context.Context) error { return nil },
+		}
+	}
+	c := newConfig([]Option{WithReader(newReader(0, 1)), WithReader(newReader(1, 0))})
+	f, _ := c.readerSignals()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	assert.NoError(t, f(ctx))
+}
+
 func TestUnifyMultiError(t *testing.T) {
 	f := func(context.Context) error { return assert.AnError }
 	funcs := []func(context.Context) error{f, f, f}
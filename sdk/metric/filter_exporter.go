@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"regexp"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// MetricFilter matches the data points of Metrics. All of its non-zero-value
+// fields need to match a data point for it to be matched. The zero value
+// matches all data points.
+type MetricFilter struct {
+	// Name, if not nil, needs to match the name of the Metrics.
+	Name *regexp.Regexp
+	// Scope, if not nil, needs to return true for the instrumentation scope
+	// of the Metrics.
+	Scope func(instrumentation.Scope) bool
+	// Attributes, if not nil, needs to return true for the attributes of the
+	// data point.
+	Attributes func(attribute.Set) bool
+}
+
+// matchMetrics returns if the Name and Scope of f match.
+func (f MetricFilter) matchMetrics(scope instrumentation.Scope, name string) bool {
+	if f.Name != nil && !f.Name.MatchString(name) {
+		return false
+	}
+	return f.Scope == nil || f.Scope(scope)
+}
+
+// FilterOption applies a configuration option value to an Exporter returned
+// by NewFilterExporter.
+type FilterOption interface {
+	applyFilter(filterConfig) filterConfig
+}
+
+// filterConfig contains configuration options for a filter exporter.
+type filterConfig struct {
+	keep []MetricFilter
+	drop []MetricFilter
+}
+
+// filterOptionFunc applies a set of options to a filterConfig.
+type filterOptionFunc func(filterConfig) filterConfig
+
+// applyFilter returns a filterConfig with option(s) applied.
+func (o filterOptionFunc) applyFilter(conf filterConfig) filterConfig {
+	return o(conf)
+}
+
+// WithFilterKeep configures a filter exporter to only export the data points
+// matched by any of filters.
+//
+// This option can be used multiple times, all filters are combined.
+func WithFilterKeep(filters ...MetricFilter) FilterOption {
+	return filterOptionFunc(func(conf filterConfig) filterConfig {
+		conf.keep = append(conf.keep, filters...)
+		return conf
+	})
+}
+
+// WithFilterDrop configures a filter exporter to not export the data points
+// matched by any of filters.
+//
+// This option can be used multiple times, all filters are combined.
+func WithFilterDrop(filters ...MetricFilter) FilterOption {
+	return filterOptionFunc(func(conf filterConfig) filterConfig {
+		conf.drop = append(conf.drop, filters...)
+		return conf
+	})
+}
+
+// filterExporter is an Exporter that filters metric data before exporting
+// it with another Exporter.
+type filterExporter struct {
+	Exporter
+
+	conf filterConfig
+}
+
+// Compile time check filterExporter implements Exporter.
+var _ Exporter = (*filterExporter)(nil)
+
+// NewFilterExporter returns an Exporter that filters the metric data it
+// exports with exporter. A data point is exported if it is matched by any
+// filter passed with WithFilterKeep, or WithFilterKeep is not used, and it is
+// not matched by any filter passed with WithFilterDrop. Metrics without any
+// exported data points, and ScopeMetrics without any exported Metrics, are
+// not exported.
+//
+// All other methods are delegated to exporter.
+func NewFilterExporter(exporter Exporter, options ...FilterOption) Exporter {
+	var conf filterConfig
+	for _, o := range options {
+		conf = o.applyFilter(conf)
+	}
+	return &filterExporter{Exporter: exporter, conf: conf}
+}
+
+// Export exports the metric data of rm e keeps with e's exporter. rm is not
+// modified.
+func (e *filterExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	out := &metricdata.ResourceMetrics{Resource: rm.Resource}
+	for _, sm := range rm.ScopeMetrics {
+		var metrics []metricdata.Metrics
+		for _, m := range sm.Metrics {
+			if m, ok := e.filterMetrics(sm.Scope, m); ok {
+				metrics = append(metrics, m)
+			}
+		}
+		if len(metrics) > 0 {
+			out.ScopeMetrics = append(out.ScopeMetrics, metricdata.ScopeMetrics{
+				Scope:   sm.Scope,
+				Metrics: metrics,
+			})
+		}
+	}
+	return e.Exporter.Export(ctx, out)
+}
+
+// filterMetrics returns m with only the data points e keeps, and if any data
+// points are kept.
+func (e *filterExporter) filterMetrics(scope instrumentation.Scope, m metricdata.Metrics) (metricdata.Metrics, bool) {
+	keep, drop := e.filters(scope, m.Name)
+	if len(e.conf.keep) > 0 && len(keep) == 0 {
+		return m, false
+	}
+	match := func(attrs attribute.Set) bool {
+		if len(keep) > 0 && !matchAny(keep, attrs) {
+			return false
+		}
+		return !matchAny(drop, attrs)
+	}
+
+	var n int
+	switch a := m.Data.(type) {
+	case metricdata.Gauge[int64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, dataPointAttrs[int64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.Gauge[float64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, dataPointAttrs[float64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.Sum[int64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, dataPointAttrs[int64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.Sum[float64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, dataPointAttrs[float64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.Histogram[int64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, histogramDataPointAttrs[int64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.Histogram[float64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, histogramDataPointAttrs[float64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.Summary[int64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, summaryDataPointAttrs[int64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.Summary[float64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, summaryDataPointAttrs[float64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, exponentialHistogramDataPointAttrs[int64], match)
+		m.Data, n = a, len(a.DataPoints)
+	case metricdata.ExponentialHistogram[float64]:
+		a.DataPoints = filterDataPoints(a.DataPoints, exponentialHistogramDataPointAttrs[float64], match)
+		m.Data, n = a, len(a.DataPoints)
+	default:
+		// Unknown aggregations are kept or dropped as a whole.
+		return m, match(*attribute.EmptySet())
+	}
+	return m, n > 0
+}
+
+// filters returns the keep and drop filters of e matching the scope and name
+// of Metrics.
+func (e *filterExporter) filters(scope instrumentation.Scope, name string) (keep, drop []MetricFilter) {
+	for _, f := range e.conf.keep {
+		if f.matchMetrics(scope, name) {
+			keep = append(keep, f)
+		}
+	}
+	for _, f := range e.conf.drop {
+		if f.matchMetrics(scope, name) {
+			drop = append(drop, f)
+		}
+	}
+	return keep, drop
+}
+
+// matchAny returns if the Attributes of any of filters match attrs.
+func matchAny(filters []MetricFilter, attrs attribute.Set) bool {
+	for _, f := range filters {
+		if f.Attributes == nil || f.Attributes(attrs) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterDataPoints returns the data points of dPts with attributes match
+// returns true for. dPts is returned as is if all of them are kept, otherwise
+// the returned slice does not share memory with dPts.
+func filterDataPoints[T any](dPts []T, attrs func(T) attribute.Set, match func(attribute.Set) bool) []T {
+	var out []T
+	for i, dp := range dPts {
+		if !match(attrs(dp)) {
+			if out == nil {
+				out = make([]T, i, len(dPts))
+				copy(out, dPts[:i])
+			}
+			continue
+		}
+		if out != nil {
+			out = append(out, dp)
+		}
+	}
+	if out == nil {
+		return dPts
+	}
+	return out
+}
+
+func dataPointAttrs[N int64 | float64](dp metricdata.DataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func histogramDataPointAttrs[N int64 | float64](dp metricdata.HistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func summaryDataPointAttrs[N int64 | float64](dp metricdata.SummaryDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func exponentialHistogramDataPointAttrs[N int64 | float64](dp metricdata.ExponentialHistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
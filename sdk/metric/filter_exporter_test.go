@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestFilterExporter(t *testing.T) {
+	attrA := attribute.NewSet(attribute.String("key", "A"))
+	attrB := attribute.NewSet(attribute.String("key", "B"))
+	sum := func(attrs ...attribute.Set) metricdata.Sum[int64] {
+		s := metricdata.Sum[int64]{Temporality: metricdata.CumulativeTemporality}
+		for _, a := range attrs {
+			s.DataPoints = append(s.DataPoints, metricdata.DataPoint[int64]{Attributes: a, Value: 1})
+		}
+		return s
+	}
+	hist := func(attrs ...attribute.Set) metricdata.Histogram[float64] {
+		h := metricdata.Histogram[float64]{Temporality: metricdata.CumulativeTemporality}
+		for _, a := range attrs {
+			h.DataPoints = append(h.DataPoints, metricdata.HistogramDataPoint[float64]{Attributes: a, Count: 1})
+		}
+		return h
+	}
+	app := instrumentation.Scope{Name: "app"}
+	lib := instrumentation.Scope{Name: "noisy/lib"}
+	rm := func() *metricdata.ResourceMetrics {
+		return &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: app,
+				Metrics: []metricdata.Metrics{
+					{Name: "requests", Data: sum(attrA, attrB)},
+					{Name: "latency", Data: hist(attrA, attrB)},
+				},
+			},
+			{
+				Scope:   lib,
+				Metrics: []metricdata.Metrics{{Name: "requests", Data: sum(attrA)}},
+			},
+		}}
+	}
+	isLib := func(s instrumentation.Scope) bool { return s == lib }
+	isA := func(s attribute.Set) bool { return s.Equals(&attrA) }
+
+	tests := []struct {
+		name string
+		opts []FilterOption
+		want []metricdata.ScopeMetrics
+	}{
+		{
+			name: "NoFilters",
+			want: rm().ScopeMetrics,
+		},
+		{
+			name: "DropScope",
+			opts: []FilterOption{WithFilterDrop(MetricFilter{Scope: isLib})},
+			want: rm().ScopeMetrics[:1],
+		},
+		{
+			name: "KeepName",
+			opts: []FilterOption{WithFilterKeep(MetricFilter{Name: regexp.MustCompile("^req")})},
+			want: []metricdata.ScopeMetrics{
+				{Scope: app, Metrics: []metricdata.Metrics{{Name: "requests", Data: sum(attrA, attrB)}}},
+				{Scope: lib, Metrics: []metricdata.Metrics{{Name: "requests", Data: sum(attrA)}}},
+			},
+		},
+		{
+			name: "DropAttributes",
+			opts: []FilterOption{WithFilterDrop(MetricFilter{Attributes: isA})},
+			want: []metricdata.ScopeMetrics{{
+				Scope: app,
+				Metrics: []metricdata.Metrics{
+					{Name: "requests", Data: sum(attrB)},
+					{Name: "latency", Data: hist(attrB)},
+				},
+			}},
+		},
+		{
+			name: "KeepAndDrop",
+			opts: []FilterOption{
+				WithFilterKeep(MetricFilter{Name: regexp.MustCompile("requests")}),
+				WithFilterDrop(MetricFilter{Scope: isLib}, MetricFilter{Name: regexp.MustCompile("requests"), Attributes: isA}),
+			},
+			want: []metricdata.ScopeMetrics{
+				{Scope: app, Metrics: []metricdata.Metrics{{Name: "requests", Data: sum(attrB)}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *metricdata.ResourceMetrics
+			exp := NewFilterExporter(&fnExporter{
+				exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+					got = rm
+					return nil
+				},
+			}, tt.opts...)
+
+			in := rm()
+			require.NoError(t, exp.Export(context.Background(), in))
+			assert.Equal(t, tt.want, got.ScopeMetrics)
+			assert.Equal(t, rm(), in, "exported ResourceMetrics modified")
+		})
+	}
+}
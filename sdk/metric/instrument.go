@@ -21,12 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 var (
@@ -152,8 +154,51 @@ type Stream struct {
 	// will record the attribute.
 	//
 	// Use NewAllowKeysFilter from "go.opentelemetry.io/otel/attribute" to
-	// provide an allow-list of attribute keys here.
+	// provide an allow-list of attribute keys here, or use
+	// NewAllowKeyPatternsFilter to provide an allow-list of attribute key
+	// patterns.
 	AttributeFilter attribute.Filter
+	// MeasurementFilter determines if a measurement is aggregated based on
+	// all attributes recorded for it, before AttributeFilter is applied. If
+	// the filter returns false the measurement is dropped, otherwise, if it
+	// returns true, the measurement is aggregated. For example, a filter
+	// returning false if the "http.route" attribute is "/healthz" drops all
+	// measurements of health checks.
+	//
+	// If MeasurementFilter is nil, all measurements are aggregated.
+	MeasurementFilter func(attribute.Set) bool
+	// AggregationLimit is the maximum number of distinct attribute sets
+	// aggregated for the stream during a collection cycle. Measurements with
+	// new attribute sets beyond the limit are aggregated into a single
+	// overflow attribute set, otel.metric.overflow=true.
+	//
+	// If AggregationLimit is less than or equal to zero, the limit set with
+	// WithCardinalityLimit for the MeterProvider is used.
+	AggregationLimit int
+	// Scale is the factor measurement values are multiplied by before they
+	// are aggregated. Use it with Unit to convert the unit of an instrument,
+	// e.g. a Scale of 0.001 with a Unit of "s" converts measurements of an
+	// instrument with a "ms" unit to seconds, and a Scale of 1.0/(1<<20)
+	// with a Unit of "MiBy" converts bytes to mebibytes. Bucket boundaries of
+	// the Aggregation apply to the scaled values.
+	//
+	// Scaled int64 values would be truncated, therefore only integral scales
+	// can be applied to int64 instruments. A stream with a non-integral Scale
+	// is not created for an int64 instrument and an error is returned when
+	// the instrument is created.
+	//
+	// If Scale is less than or equal to zero, values are not scaled.
+	Scale float64
+	// Temporality is the Temporality of the aggregated data of the stream,
+	// regardless of the Temporality the Reader selects for the instrument
+	// kind. For example, a stream of a Counter can be exported with
+	// DeltaTemporality while all other Counters use CumulativeTemporality.
+	// Exporters converting the Temporality of metric data, like the one
+	// returned by NewMultiExporter, still convert the stream.
+	//
+	// If Temporality is not DeltaTemporality or CumulativeTemporality, the
+	// Temporality selected by the Reader is used.
+	Temporality metricdata.Temporality
 }
 
 // instID are the identifying properties of a instrument.
@@ -168,6 +213,8 @@ type instID struct {
 	Unit string
 	// Number is the number type of the stream.
 	Number string
+	// Scale is the factor values of the stream are scaled by.
+	Scale float64
 }
 
 // Returns a normalized copy of the instID i.
@@ -180,8 +227,34 @@ func (i instID) normalize() instID {
 	return i
 }
 
+// measures are the aggregate function inputs an instrument is resolved to by
+// the views of a MeterProvider. They are replaced when the views of the
+// MeterProvider are updated.
+type measures[N int64 | float64] struct {
+	p atomic.Pointer[[]aggregate.Measure[N]]
+}
+
+func newMeasures[N int64 | float64](meas []aggregate.Measure[N]) *measures[N] {
+	m := &measures[N]{}
+	m.store(meas)
+	return m
+}
+
+// load returns the current aggregate function inputs.
+func (m *measures[N]) load() []aggregate.Measure[N] {
+	if p := m.p.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// store replaces the aggregate function inputs with meas.
+func (m *measures[N]) store(meas []aggregate.Measure[N]) {
+	m.p.Store(&meas)
+}
+
 type int64Inst struct {
-	measures []aggregate.Measure[int64]
+	measures *measures[int64]
 
 	embedded.Int64Counter
 	embedded.Int64UpDownCounter
@@ -208,13 +281,13 @@ func (i *int64Inst) aggregate(ctx context.Context, val int64, s attribute.Set) {
 	if err := ctx.Err(); err != nil {
 		return
 	}
-	for _, in := range i.measures {
+	for _, in := range i.measures.load() {
 		in(ctx, val, s)
 	}
 }
 
 type float64Inst struct {
-	measures []aggregate.Measure[float64]
+	measures *measures[float64]
 
 	embedded.Float64Counter
 	embedded.Float64UpDownCounter
@@ -241,7 +314,7 @@ func (i *float64Inst) aggregate(ctx context.Context, val float64, s attribute.Se
 	if err := ctx.Err(); err != nil {
 		return
 	}
-	for _, in := range i.measures {
+	for _, in := range i.measures.load() {
 		in(ctx, val, s)
 	}
 }
@@ -270,7 +343,7 @@ var (
 	_ metric.Float64ObservableGauge         = float64Observable{}
 )
 
-func newFloat64Observable(m *meter, kind InstrumentKind, name, desc, u string, meas []aggregate.Measure[float64]) float64Observable {
+func newFloat64Observable(m *meter, kind InstrumentKind, name, desc, u string, meas *measures[float64]) float64Observable {
 	return float64Observable{
 		observable: newObservable(m, kind, name, desc, u, meas),
 	}
@@ -291,7 +364,7 @@ var (
 	_ metric.Int64ObservableGauge         = int64Observable{}
 )
 
-func newInt64Observable(m *meter, kind InstrumentKind, name, desc, u string, meas []aggregate.Measure[int64]) int64Observable {
+func newInt64Observable(m *meter, kind InstrumentKind, name, desc, u string, meas *measures[int64]) int64Observable {
 	return int64Observable{
 		observable: newObservable(m, kind, name, desc, u, meas),
 	}
@@ -302,10 +375,10 @@ type observable[N int64 | float64] struct {
 	observablID[N]
 
 	meter    *meter
-	measures []aggregate.Measure[N]
+	measures *measures[N]
 }
 
-func newObservable[N int64 | float64](m *meter, kind InstrumentKind, name, desc, u string, meas []aggregate.Measure[N]) *observable[N] {
+func newObservable[N int64 | float64](m *meter, kind InstrumentKind, name, desc, u string, meas *measures[N]) *observable[N] {
 	return &observable[N]{
 		observablID: observablID[N]{
 			name:        name,
@@ -321,7 +394,7 @@ func newObservable[N int64 | float64](m *meter, kind InstrumentKind, name, desc,
 
 // observe records the val for the set of attrs.
 func (o *observable[N]) observe(val N, s attribute.Set) {
-	for _, in := range o.measures {
+	for _, in := range o.measures.load() {
 		in(context.Background(), val, s)
 	}
 }
@@ -329,13 +402,10 @@ func (o *observable[N]) observe(val N, s attribute.Set) {
 var errEmptyAgg = errors.New("no aggregators for observable instrument")
 
 // registerable returns an error if the observable o should not be registered,
-// and nil if it should. An errEmptyAgg error is returned if o is effectively a
-// no-op because it does not have any aggregators. Also, an error is returned
-// if scope defines a Meter other than the one o was created by.
+// and nil if it should. An error is returned if scope defines a Meter other
+// than the one o was created by. Otherwise, an errEmptyAgg error is returned
+// if o is currently a no-op because it does not have any aggregators.
 func (o *observable[N]) registerable(m *meter) error {
-	if len(o.measures) == 0 {
-		return errEmptyAgg
-	}
 	if m != o.meter {
 		return fmt.Errorf(
 			"invalid registration: observable %q from Meter %q, registered with Meter %q",
@@ -344,5 +414,8 @@ func (o *observable[N]) registerable(m *meter) error {
 			m.scope.Name,
 		)
 	}
+	if len(o.measures.load()) == 0 {
+		return errEmptyAgg
+	}
 	return nil
 }
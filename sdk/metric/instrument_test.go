@@ -47,7 +47,7 @@ func BenchmarkInstrument(b *testing.B) {
 		in, _ = build.Sum(true)
 		meas = append(meas, in)
 
-		inst := int64Inst{measures: meas}
+		inst := int64Inst{measures: newMeasures(meas)}
 		ctx := context.Background()
 
 		b.ReportAllocs()
@@ -72,7 +72,7 @@ func BenchmarkInstrument(b *testing.B) {
 		in, _ = build.Sum(true)
 		meas = append(meas, in)
 
-		o := observable[int64]{measures: meas}
+		o := observable[int64]{measures: newMeasures(meas)}
 
 		b.ReportAllocs()
 		b.ResetTimer()
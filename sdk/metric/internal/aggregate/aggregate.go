@@ -16,6 +16,7 @@ package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggreg
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -44,14 +45,57 @@ type Builder[N int64 | float64] struct {
 	// Filter is the attribute filter the aggregate function will use on the
 	// input of measurements.
 	Filter attribute.Filter
+	// MeasurementFilter, if not nil, determines if a measurement is
+	// aggregated based on its attributes, before Filter is applied to them.
+	// Measurements it returns false for are dropped.
+	MeasurementFilter func(attribute.Set) bool
+	// AggregationLimit is the cardinality limit of measurement attributes.
+	// Once the limit has been reached, measurements for new attribute sets
+	// are aggregated into a single attribute set identifying the overflow.
+	//
+	// If AggregationLimit is less than or equal to zero, no limit is applied.
+	AggregationLimit int
+	// Overflows, if not nil, is incremented for every measurement aggregated
+	// into the overflow attribute set.
+	Overflows *atomic.Uint64
+	// Scale is the factor measurement values are multiplied by before they
+	// are aggregated. Scaled int64 values are truncated toward zero, it is
+	// expected to be integral for int64 Builders.
+	//
+	// If Scale is less than or equal to zero, values are not scaled.
+	Scale float64
+}
+
+func (b Builder[N]) limiter() limiter {
+	return limiter{limit: b.AggregationLimit, overflows: b.Overflows}
+}
+
+func (b Builder[N]) scale(f Measure[N]) Measure[N] {
+	if b.Scale > 0 && b.Scale != 1 {
+		scale := b.Scale // Copy to make it immutable after assignment.
+		return func(ctx context.Context, n N, a attribute.Set) {
+			f(ctx, N(float64(n)*scale), a)
+		}
+	}
+	return f
 }
 
 func (b Builder[N]) filter(f Measure[N]) Measure[N] {
 	if b.Filter != nil {
 		fltr := b.Filter // Copy to make it immutable after assignment.
-		return func(ctx context.Context, n N, a attribute.Set) {
+		next := f
+		f = func(ctx context.Context, n N, a attribute.Set) {
 			fAttr, _ := a.Filter(fltr)
-			f(ctx, n, fAttr)
+			next(ctx, n, fAttr)
+		}
+	}
+	if b.MeasurementFilter != nil {
+		keep := b.MeasurementFilter // Copy to make it immutable after assignment.
+		next := f
+		f = func(ctx context.Context, n N, a attribute.Set) {
+			if keep(a) {
+				next(ctx, n, a)
+			}
 		}
 	}
 	return f
@@ -63,9 +107,9 @@ func (b Builder[N]) filter(f Measure[N]) Measure[N] {
 func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
 	// Delta temporality is the only temporality that makes semantic sense for
 	// a last-value aggregate.
-	lv := newLastValue[N]()
+	lv := newLastValue[N](b.limiter())
 
-	return b.filter(lv.measure), func(dest *metricdata.Aggregation) int {
+	return b.filter(b.scale(lv.measure)), func(dest *metricdata.Aggregation) int {
 		// Ignore if dest is not a metricdata.Gauge. The chance for memory
 		// reuse of the DataPoints is missed (better luck next time).
 		gData, _ := (*dest).(metricdata.Gauge[N])
@@ -79,47 +123,60 @@ func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
 // PrecomputedSum returns a sum aggregate function input and output. The
 // arguments passed to the input are expected to be the precomputed sum values.
 func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregation) {
-	s := newPrecomputedSum[N](monotonic)
+	s := newPrecomputedSum[N](monotonic, b.limiter())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(s.measure), s.delta
+		return b.filter(b.scale(s.measure)), s.delta
 	default:
-		return b.filter(s.measure), s.cumulative
+		return b.filter(b.scale(s.measure)), s.cumulative
 	}
 }
 
 // Sum returns a sum aggregate function input and output.
 func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
-	s := newSum[N](monotonic)
+	s := newSum[N](monotonic, b.limiter())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(s.measure), s.delta
+		return b.filter(b.scale(s.measure)), s.delta
 	default:
-		return b.filter(s.measure), s.cumulative
+		return b.filter(b.scale(s.measure)), s.cumulative
 	}
 }
 
 // ExplicitBucketHistogram returns a histogram aggregate function input and
 // output.
 func (b Builder[N]) ExplicitBucketHistogram(boundaries []float64, noMinMax, noSum bool) (Measure[N], ComputeAggregation) {
-	h := newHistogram[N](boundaries, noMinMax, noSum)
+	h := newHistogram[N](boundaries, noMinMax, noSum, b.limiter())
+	switch b.Temporality {
+	case metricdata.DeltaTemporality:
+		return b.filter(b.scale(h.measure)), h.delta
+	default:
+		return b.filter(b.scale(h.measure)), h.cumulative
+	}
+}
+
+// Summary returns a summary aggregate function input and output. The summary
+// is the count, sum, minimum, and maximum of measurements. If noSum is true,
+// the sum is not recorded.
+func (b Builder[N]) Summary(noSum bool) (Measure[N], ComputeAggregation) {
+	s := newSummary[N](noSum, b.limiter())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(h.measure), h.delta
+		return b.filter(b.scale(s.measure)), s.delta
 	default:
-		return b.filter(h.measure), h.cumulative
+		return b.filter(b.scale(s.measure)), s.cumulative
 	}
 }
 
 // ExponentialBucketHistogram returns a histogram aggregate function input and
 // output.
 func (b Builder[N]) ExponentialBucketHistogram(maxSize, maxScale int32, noMinMax, noSum bool) (Measure[N], ComputeAggregation) {
-	h := newExponentialHistogram[N](maxSize, maxScale, noMinMax, noSum)
+	h := newExponentialHistogram[N](maxSize, maxScale, noMinMax, noSum, b.limiter())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		return b.filter(h.measure), h.delta
+		return b.filter(b.scale(h.measure)), h.delta
 	default:
-		return b.filter(h.measure), h.cumulative
+		return b.filter(b.scale(h.measure)), h.cumulative
 	}
 }
 
@@ -79,6 +79,49 @@ func testBuilderFilter[N int64 | float64]() func(t *testing.T) {
 
 		t.Run("NoFilter", run(Builder[N]{}, attr))
 		t.Run("Filter", run(Builder[N]{Filter: attrFltr}, fltrAlice))
+
+		keep := func(s attribute.Set) bool {
+			// Measurements are filtered before their attributes are.
+			assert.Equal(t, attr, s, "measurement filter attributes")
+			return true
+		}
+		t.Run("MeasurementFilter", run(Builder[N]{
+			Filter:            attrFltr,
+			MeasurementFilter: keep,
+		}, fltrAlice))
+
+		drop := func(attribute.Set) bool { return false }
+		meas := Builder[N]{MeasurementFilter: drop}.filter(func(context.Context, N, attribute.Set) {
+			t.Error("dropped measurement measured")
+		})
+		meas(context.Background(), value, attr)
+	}
+}
+
+func TestBuilderScale(t *testing.T) {
+	t.Run("Int64", testBuilderScale[int64](1500, 1))
+	t.Run("Float64", testBuilderScale[float64](1500, 1.5))
+}
+
+func testBuilderScale[N int64 | float64](value, scaled N) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		run := func(b Builder[N], want N) func(*testing.T) {
+			return func(t *testing.T) {
+				t.Helper()
+
+				meas := b.scale(func(_ context.Context, v N, a attribute.Set) {
+					assert.Equal(t, want, v, "measured incorrect value")
+					assert.Equal(t, alice, a, "measured incorrect attributes")
+				})
+				meas(context.Background(), value, alice)
+			}
+		}
+
+		t.Run("NoScale", run(Builder[N]{}, value))
+		t.Run("Negative", run(Builder[N]{Scale: -1}, value))
+		t.Run("Scale", run(Builder[N]{Scale: 0.001}, scaled))
 	}
 }
 
@@ -185,7 +185,7 @@ func testExpoHistogramMinMaxSumInt64(t *testing.T) {
 			restore := withHandler(t)
 			defer restore()
 
-			h := newExponentialHistogram[int64](4, 20, false, false)
+			h := newExponentialHistogram[int64](4, 20, false, false, limiter{})
 			for _, v := range tt.values {
 				h.measure(context.Background(), v, alice)
 			}
@@ -227,7 +227,7 @@ func testExpoHistogramMinMaxSumFloat64(t *testing.T) {
 			restore := withHandler(t)
 			defer restore()
 
-			h := newExponentialHistogram[float64](4, 20, false, false)
+			h := newExponentialHistogram[float64](4, 20, false, false, limiter{})
 			for _, v := range tt.values {
 				h.measure(context.Background(), v, alice)
 			}
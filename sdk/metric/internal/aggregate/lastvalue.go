@@ -29,20 +29,25 @@ type datapoint[N int64 | float64] struct {
 	value     N
 }
 
-func newLastValue[N int64 | float64]() *lastValue[N] {
-	return &lastValue[N]{values: make(map[attribute.Set]datapoint[N])}
+func newLastValue[N int64 | float64](limit limiter) *lastValue[N] {
+	return &lastValue[N]{
+		limit:  limit,
+		values: make(map[attribute.Set]datapoint[N]),
+	}
 }
 
 // lastValue summarizes a set of measurements as the last one made.
 type lastValue[N int64 | float64] struct {
 	sync.Mutex
 
+	limit  limiter
 	values map[attribute.Set]datapoint[N]
 }
 
 func (s *lastValue[N]) measure(ctx context.Context, value N, attr attribute.Set) {
 	d := datapoint[N]{timestamp: now(), value: value}
 	s.Lock()
+	attr = limitAttr(s.limit, attr, s.values)
 	s.values[attr] = d
 	s.Unlock()
 }
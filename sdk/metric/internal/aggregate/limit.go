@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// overflowSet is the attribute set used to record a measurement when adding
+// another distinct attribute set to the aggregate would exceed the aggregate
+// limit.
+var overflowSet = attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+
+// limiter limits the number of distinct attribute sets an aggregate function
+// stores.
+type limiter struct {
+	// limit is the maximum number of distinct attribute sets, including
+	// overflowSet, stored. If limit is less than or equal to zero, the number
+	// is not limited.
+	limit int
+	// overflows, if not nil, is incremented for every measurement recorded
+	// for overflowSet.
+	overflows *atomic.Uint64
+}
+
+// limitAttr returns attr if a measurement for it can be stored in values
+// without exceeding the limit of l. Otherwise, overflowSet is returned.
+//
+// The lock guarding values needs to be held by the caller.
+func limitAttr[V any](l limiter, attr attribute.Set, values map[attribute.Set]V) attribute.Set {
+	if l.limit <= 0 {
+		return attr
+	}
+	if _, ok := values[attr]; ok || len(values) < l.limit-1 {
+		return attr
+	}
+	if l.overflows != nil {
+		l.overflows.Add(1)
+	}
+	return overflowSet
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestLimitAttr(t *testing.T) {
+	var overflows atomic.Uint64
+	l := limiter{limit: 2, overflows: &overflows}
+
+	m := map[attribute.Set]struct{}{alice: {}}
+	assert.Equal(t, alice, limitAttr(l, alice, m), "stored attributes")
+	assert.Equal(t, overflowSet, limitAttr(l, bob, m), "new attributes")
+	assert.Equal(t, uint64(1), overflows.Load())
+
+	assert.Equal(t, bob, limitAttr(limiter{}, bob, m), "no limit")
+	assert.Equal(t, bob, limitAttr(limiter{limit: 3}, bob, m), "below limit")
+}
+
+func TestBuilderAggregationLimit(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	var overflows atomic.Uint64
+	in, out := Builder[int64]{
+		Temporality:      metricdata.CumulativeTemporality,
+		AggregationLimit: 2,
+		Overflows:        &overflows,
+	}.Sum(true)
+
+	ctx := context.Background()
+	carol := attribute.NewSet(attribute.String(keyUser, "Carol"))
+	in(ctx, 1, alice)
+	in(ctx, 2, bob)
+	in(ctx, 3, carol)
+	in(ctx, 4, alice)
+
+	var got metricdata.Aggregation
+	assert.Equal(t, 2, out(&got))
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: alice, StartTime: staticTime, Time: staticTime, Value: 5},
+			{Attributes: overflowSet, StartTime: staticTime, Time: staticTime, Value: 5},
+		},
+	}, got)
+	assert.Equal(t, uint64(2), overflows.Load())
+}
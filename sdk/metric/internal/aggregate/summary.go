@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// summaryValue is the count, sum, minimum, and maximum of measurements.
+type summaryValue[N int64 | float64] struct {
+	count    uint64
+	total    N
+	min, max N
+}
+
+// summary summarizes a set of measurements as their count, sum, minimum, and
+// maximum.
+type summary[N int64 | float64] struct {
+	noSum bool
+
+	limit    limiter
+	values   map[attribute.Set]*summaryValue[N]
+	valuesMu sync.Mutex
+
+	start time.Time
+}
+
+// newSummary returns an Aggregator that summarizes a set of measurements as
+// their count, sum, minimum, and maximum.
+func newSummary[N int64 | float64](noSum bool, limit limiter) *summary[N] {
+	return &summary[N]{
+		noSum:  noSum,
+		limit:  limit,
+		values: make(map[attribute.Set]*summaryValue[N]),
+		start:  now(),
+	}
+}
+
+func (s *summary[N]) measure(_ context.Context, value N, attr attribute.Set) {
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	attr = limitAttr(s.limit, attr, s.values)
+	v, ok := s.values[attr]
+	if !ok {
+		v = &summaryValue[N]{min: value, max: value}
+		s.values[attr] = v
+	}
+	v.count++
+	if !s.noSum {
+		v.total += value
+	}
+	if value < v.min {
+		v.min = value
+	}
+	if value > v.max {
+		v.max = value
+	}
+}
+
+func (s *summary[N]) delta(dest *metricdata.Aggregation) int {
+	t := now()
+
+	// If *dest is not a metricdata.Summary, memory reuse is missed. In that
+	// case, use the zero-value sData and hope for better alignment next cycle.
+	sData, _ := (*dest).(metricdata.Summary[N])
+	sData.Temporality = metricdata.DeltaTemporality
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	n := len(s.values)
+	dPts := reset(sData.DataPoints, n, n)
+
+	var i int
+	for a, v := range s.values {
+		dPts[i] = s.dataPoint(a, v, t)
+		// Unused attribute sets do not report.
+		delete(s.values, a)
+		i++
+	}
+	// The delta collection cycle resets.
+	s.start = t
+
+	sData.DataPoints = dPts
+	*dest = sData
+
+	return n
+}
+
+func (s *summary[N]) cumulative(dest *metricdata.Aggregation) int {
+	t := now()
+
+	// If *dest is not a metricdata.Summary, memory reuse is missed. In that
+	// case, use the zero-value sData and hope for better alignment next cycle.
+	sData, _ := (*dest).(metricdata.Summary[N])
+	sData.Temporality = metricdata.CumulativeTemporality
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	n := len(s.values)
+	dPts := reset(sData.DataPoints, n, n)
+
+	var i int
+	for a, v := range s.values {
+		dPts[i] = s.dataPoint(a, v, t)
+		i++
+		// TODO (#3006): This will use an unbounded amount of memory if there
+		// are unbounded number of attribute sets being aggregated. Attribute
+		// sets that become "stale" need to be forgotten so this will not
+		// overload the system.
+	}
+
+	sData.DataPoints = dPts
+	*dest = sData
+
+	return n
+}
+
+// dataPoint returns the SummaryDataPoint of v for the attributes a at time t.
+func (s *summary[N]) dataPoint(a attribute.Set, v *summaryValue[N], t time.Time) metricdata.SummaryDataPoint[N] {
+	dp := metricdata.SummaryDataPoint[N]{
+		Attributes: a,
+		StartTime:  s.start,
+		Time:       t,
+		Count:      v.count,
+		Min:        metricdata.NewExtrema(v.min),
+		Max:        metricdata.NewExtrema(v.max),
+	}
+	if !s.noSum {
+		dp.Sum = v.total
+	}
+	return dp
+}
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregate // import "go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestSummary(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	t.Run("Int64/Delta", testDeltaSummary[int64]())
+	t.Run("Float64/Delta", testDeltaSummary[float64]())
+
+	t.Run("Int64/Cumulative", testCumulativeSummary[int64]())
+	t.Run("Float64/Cumulative", testCumulativeSummary[float64]())
+}
+
+func testDeltaSummary[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality: metricdata.DeltaTemporality,
+		Filter:      attrFltr,
+	}.Summary(false)
+	ctx := context.Background()
+	return test[N](in, out, []teststep[N]{
+		{
+			input: []arg[N]{},
+			expect: output{
+				n: 0,
+				agg: metricdata.Summary[N]{
+					Temporality: metricdata.DeltaTemporality,
+					DataPoints:  []metricdata.SummaryDataPoint[N]{},
+				},
+			},
+		},
+		{
+			input: []arg[N]{
+				{ctx, 2, alice},
+				{ctx, -1, bob},
+				{ctx, 1, alice},
+				{ctx, 5, alice},
+			},
+			expect: output{
+				n: 2,
+				agg: metricdata.Summary[N]{
+					Temporality: metricdata.DeltaTemporality,
+					DataPoints: []metricdata.SummaryDataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  staticTime,
+							Time:       staticTime,
+							Count:      3,
+							Min:        metricdata.NewExtrema[N](1),
+							Max:        metricdata.NewExtrema[N](5),
+							Sum:        8,
+						},
+						{
+							Attributes: fltrBob,
+							StartTime:  staticTime,
+							Time:       staticTime,
+							Count:      1,
+							Min:        metricdata.NewExtrema[N](-1),
+							Max:        metricdata.NewExtrema[N](-1),
+							Sum:        -1,
+						},
+					},
+				},
+			},
+		},
+		{
+			// Delta summaries are reset.
+			input: []arg[N]{
+				{ctx, 3, alice},
+			},
+			expect: output{
+				n: 1,
+				agg: metricdata.Summary[N]{
+					Temporality: metricdata.DeltaTemporality,
+					DataPoints: []metricdata.SummaryDataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  staticTime,
+							Time:       staticTime,
+							Count:      1,
+							Min:        metricdata.NewExtrema[N](3),
+							Max:        metricdata.NewExtrema[N](3),
+							Sum:        3,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func testCumulativeSummary[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality: metricdata.CumulativeTemporality,
+		Filter:      attrFltr,
+	}.Summary(true)
+	ctx := context.Background()
+	return test[N](in, out, []teststep[N]{
+		{
+			input: []arg[N]{
+				{ctx, 2, alice},
+				{ctx, 1, alice},
+			},
+			expect: output{
+				n: 1,
+				agg: metricdata.Summary[N]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.SummaryDataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  staticTime,
+							Time:       staticTime,
+							Count:      2,
+							Min:        metricdata.NewExtrema[N](1),
+							Max:        metricdata.NewExtrema[N](2),
+						},
+					},
+				},
+			},
+		},
+		{
+			input: []arg[N]{
+				{ctx, 7, alice},
+			},
+			expect: output{
+				n: 1,
+				agg: metricdata.Summary[N]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.SummaryDataPoint[N]{
+						{
+							Attributes: fltrAlice,
+							StartTime:  staticTime,
+							Time:       staticTime,
+							Count:      3,
+							Min:        metricdata.NewExtrema[N](1),
+							Max:        metricdata.NewExtrema[N](7),
+						},
+					},
+				},
+			},
+		},
+	})
+}
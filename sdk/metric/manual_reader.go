@@ -22,6 +22,7 @@ import (
 	"sync/atomic"
 
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -37,6 +38,8 @@ type ManualReader struct {
 
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+
+	last lastCollection
 }
 
 // Compile time check the manualReader implements Reader and is comparable.
@@ -48,6 +51,7 @@ func NewManualReader(opts ...ManualReaderOption) *ManualReader {
 	r := &ManualReader{
 		temporalitySelector: cfg.temporalitySelector,
 		aggregationSelector: cfg.aggregationSelector,
+		last:                lastCollection{enabled: cfg.keepLast},
 	}
 	r.externalProducers.Store(cfg.producers)
 	return r
@@ -57,7 +61,12 @@ func NewManualReader(opts ...ManualReaderOption) *ManualReader {
 // to read metrics from the SDK on demand.
 func (mr *ManualReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
-	if !mr.sdkProducer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
+	ph := produceHolder{
+		produce:         p.produce,
+		produceMatching: p.produceMatching,
+		produceBatches:  p.produceBatches,
+	}
+	if !mr.sdkProducer.CompareAndSwap(nil, ph) {
 		msg := "did not register manual reader"
 		global.Error(errDuplicateRegister, msg)
 	}
@@ -81,7 +90,9 @@ func (mr *ManualReader) Shutdown(context.Context) error {
 	mr.shutdownOnce.Do(func() {
 		// Any future call to Collect will now return ErrReaderShutdown.
 		mr.sdkProducer.Store(produceHolder{
-			produce: shutdownProducer{}.produce,
+			produce:         shutdownProducer{}.produce,
+			produceMatching: shutdownProducer{}.produceMatching,
+			produceBatches:  shutdownProducer{}.produceBatches,
 		})
 		mr.mu.Lock()
 		defer mr.mu.Unlock()
@@ -105,22 +116,12 @@ func (mr *ManualReader) Collect(ctx context.Context, rm *metricdata.ResourceMetr
 	if rm == nil {
 		return errors.New("manual reader: *metricdata.ResourceMetrics is nil")
 	}
-	p := mr.sdkProducer.Load()
-	if p == nil {
-		return ErrReaderNotRegistered
-	}
-
-	ph, ok := p.(produceHolder)
-	if !ok {
-		// The atomic.Value is entirely in the periodicReader's control so
-		// this should never happen. In the unforeseen case that this does
-		// happen, return an error instead of panicking so a users code does
-		// not halt in the processes.
-		err := fmt.Errorf("manual reader: invalid producer: %T", p)
+	ph, err := mr.producer()
+	if err != nil {
 		return err
 	}
 
-	err := ph.produce(ctx, rm)
+	err = ph.produce(ctx, rm)
 	if err != nil {
 		return err
 	}
@@ -134,10 +135,82 @@ func (mr *ManualReader) Collect(ctx context.Context, rm *metricdata.ResourceMetr
 	}
 
 	global.Debug("ManualReader collection", "Data", rm)
+	if len(errs) == 0 {
+		mr.last.store(rm)
+	}
 
 	return unifyErrors(errs)
 }
 
+// LastCollection returns a copy of the metric data of the most recent
+// successful call to Collect, and true. If the ManualReader was not created
+// with the WithLastCollection option, or Collect has not succeeded yet, an
+// empty ResourceMetrics and false are returned.
+//
+// Collections made with CollectMatching are not kept.
+//
+// This method is safe to call concurrently.
+func (mr *ManualReader) LastCollection() (metricdata.ResourceMetrics, bool) {
+	return mr.last.load()
+}
+
+// CollectMatching gathers the metric data of the instruments match returns
+// true for from the SDK and stores the result in rm. match is called with the
+// instrumentation scope and the name of each instrument stream. Only the
+// aggregations of matching instruments are computed, which makes this
+// cheaper than Collect when only a few instruments are of interest.
+//
+// All registered callbacks are still run. External Producers are not
+// collected from. When DeltaTemporality is used, only the matching
+// instruments start a new collection interval.
+//
+// CollectMatching will return an error if called after shutdown.
+// CollectMatching will return an error if rm is a nil ResourceMetrics.
+// CollectMatching will return an error if match is nil.
+// CollectMatching will return an error if the context's Done channel is closed.
+//
+// This method is safe to call concurrently.
+func (mr *ManualReader) CollectMatching(ctx context.Context, rm *metricdata.ResourceMetrics, match func(scope instrumentation.Scope, name string) bool) error {
+	if rm == nil {
+		return errors.New("manual reader: *metricdata.ResourceMetrics is nil")
+	}
+	if match == nil {
+		return errors.New("manual reader: match function is nil")
+	}
+	ph, err := mr.producer()
+	if err != nil {
+		return err
+	}
+
+	err = ph.produceMatching(ctx, rm, match)
+	if err != nil {
+		return err
+	}
+
+	global.Debug("ManualReader collection", "Data", rm)
+
+	return nil
+}
+
+// producer returns the registered produceHolder of mr.
+func (mr *ManualReader) producer() (produceHolder, error) {
+	p := mr.sdkProducer.Load()
+	if p == nil {
+		return produceHolder{}, ErrReaderNotRegistered
+	}
+
+	ph, ok := p.(produceHolder)
+	if !ok {
+		// The atomic.Value is entirely in the periodicReader's control so
+		// this should never happen. In the unforeseen case that this does
+		// happen, return an error instead of panicking so a users code does
+		// not halt in the processes.
+		err := fmt.Errorf("manual reader: invalid producer: %T", p)
+		return produceHolder{}, err
+	}
+	return ph, nil
+}
+
 // MarshalLog returns logging data about the ManualReader.
 func (r *ManualReader) MarshalLog() interface{} {
 	r.mu.Lock()
@@ -159,6 +232,7 @@ type manualReaderConfig struct {
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
 	producers           []Producer
+	keepLast            bool
 }
 
 // newManualReaderConfig returns a manualReaderConfig configured with options.
@@ -20,9 +20,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -123,3 +125,100 @@ func TestManualReaderCollect(t *testing.T) {
 		})
 	}
 }
+
+func TestManualReaderCollectMatching(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+	ctx := context.Background()
+
+	for _, scope := range []string{"a", "b"} {
+		meter := mp.Meter(scope)
+		for _, name := range []string{"hot", "cold"} {
+			c, err := meter.Int64Counter(name)
+			require.NoError(t, err)
+			c.Add(ctx, 1)
+		}
+	}
+
+	rm := &metricdata.ResourceMetrics{}
+	err := rdr.CollectMatching(ctx, rm, func(scope instrumentation.Scope, name string) bool {
+		return scope.Name == "a" && name == "hot"
+	})
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, "a", rm.ScopeMetrics[0].Scope.Name)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	assert.Equal(t, "hot", rm.ScopeMetrics[0].Metrics[0].Name)
+
+	assert.Error(t, rdr.CollectMatching(ctx, nil, func(instrumentation.Scope, string) bool { return true }))
+	assert.Error(t, rdr.CollectMatching(ctx, rm, nil))
+
+	require.NoError(t, rdr.Shutdown(ctx))
+	assert.ErrorIs(t, rdr.CollectMatching(ctx, rm, func(instrumentation.Scope, string) bool { return true }), ErrReaderShutdown)
+}
+
+func TestManualReaderCollectMatchingNotRegistered(t *testing.T) {
+	rdr := NewManualReader()
+	rm := &metricdata.ResourceMetrics{}
+	err := rdr.CollectMatching(context.Background(), rm, func(instrumentation.Scope, string) bool { return true })
+	assert.ErrorIs(t, err, ErrReaderNotRegistered)
+}
+
+func TestManualReaderLastCollection(t *testing.T) {
+	ctx := context.Background()
+
+	rdr := NewManualReader()
+	rdr.register(testSDKProducer{})
+	rm := &metricdata.ResourceMetrics{}
+	require.NoError(t, rdr.Collect(ctx, rm))
+	_, ok := rdr.LastCollection()
+	assert.False(t, ok, "last collection kept without option")
+
+	rdr = NewManualReader(WithLastCollection())
+	_, ok = rdr.LastCollection()
+	assert.False(t, ok, "last collection before any collection")
+
+	rdr.register(testSDKProducer{
+		produceFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			*rm = testResourceMetricsA.Clone()
+			return nil
+		},
+	})
+	require.NoError(t, rdr.Collect(ctx, rm))
+	got, ok := rdr.LastCollection()
+	require.True(t, ok)
+	assert.Equal(t, testResourceMetricsA, got)
+
+	// The kept collection must not share memory with the collected one.
+	rm.ScopeMetrics[0].Metrics[0].Name = "changed"
+	got, _ = rdr.LastCollection()
+	assert.Equal(t, testResourceMetricsA, got)
+}
+
+func TestManualReaderLastCollectionProducerError(t *testing.T) {
+	ctx := context.Background()
+
+	var produceErr error
+	rdr := NewManualReader(WithLastCollection(), WithProducer(testExternalProducer{
+		produceFunc: func(context.Context) ([]metricdata.ScopeMetrics, error) {
+			return []metricdata.ScopeMetrics{testScopeMetricsB}, produceErr
+		},
+	}))
+	rdr.register(testSDKProducer{
+		produceFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			*rm = testResourceMetricsA.Clone()
+			return nil
+		},
+	})
+
+	rm := &metricdata.ResourceMetrics{}
+	require.NoError(t, rdr.Collect(ctx, rm))
+	want, ok := rdr.LastCollection()
+	require.True(t, ok)
+
+	produceErr = assert.AnError
+	assert.ErrorIs(t, rdr.Collect(ctx, rm), assert.AnError)
+	got, ok := rdr.LastCollection()
+	require.True(t, ok)
+	assert.Equal(t, want, got, "failed collection kept")
+}
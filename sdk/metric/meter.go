@@ -18,12 +18,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
-	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
 )
 
 // ErrInstrumentName indicates the created instrument has an invalid name.
@@ -40,20 +40,97 @@ type meter struct {
 	scope instrumentation.Scope
 	pipes pipelines
 
+	// mu guards the resolvers and resolved instruments. It is held while
+	// instruments are resolved so the views of the pipes are not updated
+	// concurrently.
+	mu              sync.Mutex
 	int64Resolver   resolver[int64]
 	float64Resolver resolver[float64]
+	int64Insts      resolvedInsts[int64]
+	float64Insts    resolvedInsts[float64]
 }
 
 func newMeter(s instrumentation.Scope, p pipelines) *meter {
+	m := &meter{scope: s, pipes: p}
+	m.newResolvers()
+	return m
+}
+
+// newResolvers sets new resolvers for m. m.mu needs to be held by the caller
+// if m is in use.
+func (m *meter) newResolvers() {
 	// viewCache ensures instrument conflicts, including number conflicts, this
 	// meter is asked to create are logged to the user.
 	var viewCache cache[string, instID]
 
-	return &meter{
-		scope:           s,
-		pipes:           p,
-		int64Resolver:   newResolver[int64](p, &viewCache),
-		float64Resolver: newResolver[float64](p, &viewCache),
+	m.int64Resolver = newResolver[int64](m.pipes, &viewCache)
+	m.float64Resolver = newResolver[float64](m.pipes, &viewCache)
+}
+
+// resolveAgain resolves all instruments m has created again with new
+// resolvers. It is called once the views of the pipes have been updated.
+// m.mu needs to be held by the caller.
+func (m *meter) resolveAgain() error {
+	m.newResolvers()
+	errs := &multierror{}
+	m.int64Insts.resolveAgain(m.int64Resolver, errs)
+	m.float64Insts.resolveAgain(m.float64Resolver, errs)
+	return errs.errorOrNil()
+}
+
+// resolvedInsts are the instruments a meter has resolved aggregate function
+// inputs for, kept in creation order.
+type resolvedInsts[N int64 | float64] struct {
+	order []Instrument
+	insts map[instID]*resolvedInst[N]
+}
+
+// resolvedInst is an instrument resolved to its aggregate function inputs.
+type resolvedInst[N int64 | float64] struct {
+	measures *measures[N]
+	err      error
+}
+
+// resolve returns the aggregate function inputs of inst resolved by r. An
+// instrument is only resolved once, the first result is returned for all
+// subsequent calls.
+func (ri *resolvedInsts[N]) resolve(r resolver[N], inst Instrument) (*measures[N], error) {
+	id := resolvedID(inst)
+	if res, ok := ri.insts[id]; ok {
+		return res.measures, res.err
+	}
+	if ri.insts == nil {
+		ri.insts = make(map[instID]*resolvedInst[N])
+	}
+
+	aggs, err := r.Aggregators(inst)
+	res := &resolvedInst[N]{measures: newMeasures(aggs), err: err}
+	ri.insts[id] = res
+	ri.order = append(ri.order, inst)
+	return res.measures, res.err
+}
+
+// resolvedID returns the identifier of inst within its meter.
+func resolvedID(inst Instrument) instID {
+	return instID{
+		Name:        inst.Name,
+		Description: inst.Description,
+		Kind:        inst.Kind,
+		Unit:        inst.Unit,
+	}
+}
+
+// resolveAgain resolves all instruments again with r, replacing their
+// aggregate function inputs. Any errors are appended to errs.
+func (ri *resolvedInsts[N]) resolveAgain(r resolver[N], errs *multierror) {
+	for _, inst := range ri.order {
+		res := ri.insts[resolvedID(inst)]
+		aggs, err := r.Aggregators(inst)
+		res.measures.store(aggs)
+		res.err = err
+		if err != nil {
+			errs.append(err)
+		}
 	}
 }
 
@@ -307,21 +384,21 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 
 		switch o := inst.(type) {
 		case int64Observable:
-			if err := o.registerable(m); err != nil {
-				if !errors.Is(err, errEmptyAgg) {
-					errs.append(err)
-				}
+			// Instruments without aggregators are registered as well, views
+			// can be updated to aggregate them.
+			if err := o.registerable(m); err != nil && !errors.Is(err, errEmptyAgg) {
+				errs.append(err)
 				continue
 			}
-			reg.registerInt64(o.observablID)
+			reg.registerInt64(o.observablID, o.measures)
 		case float64Observable:
-			if err := o.registerable(m); err != nil {
-				if !errors.Is(err, errEmptyAgg) {
-					errs.append(err)
-				}
+			// Instruments without aggregators are registered as well, views
+			// can be updated to aggregate them.
+			if err := o.registerable(m); err != nil && !errors.Is(err, errEmptyAgg) {
+				errs.append(err)
 				continue
 			}
-			reg.registerFloat64(o.observablID)
+			reg.registerFloat64(o.observablID, o.measures)
 		default:
 			// Instrument external to the SDK.
 			return nil, fmt.Errorf("invalid observable: from different implementation")
@@ -330,26 +407,32 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 
 	err := errs.errorOrNil()
 	if reg.len() == 0 {
-		// All insts use drop aggregation or are invalid.
+		// All insts are invalid.
 		return noopRegister{}, err
 	}
 
 	// Some or all instruments were valid.
-	cback := func(ctx context.Context) error { return f(ctx, reg) }
+	cback := func(ctx context.Context) error {
+		if reg.dropped() {
+			// All insts use drop aggregation, until views are updated.
+			return nil
+		}
+		return f(ctx, reg)
+	}
 	return m.pipes.registerMultiCallback(cback), err
 }
 
 type observer struct {
 	embedded.Observer
 
-	float64 map[observablID[float64]]struct{}
-	int64   map[observablID[int64]]struct{}
+	float64 map[observablID[float64]]*measures[float64]
+	int64   map[observablID[int64]]*measures[int64]
 }
 
 func newObserver() observer {
 	return observer{
-		float64: make(map[observablID[float64]]struct{}),
-		int64:   make(map[observablID[int64]]struct{}),
+		float64: make(map[observablID[float64]]*measures[float64]),
+		int64:   make(map[observablID[int64]]*measures[int64]),
 	}
 }
 
@@ -357,12 +440,28 @@ func (r observer) len() int {
 	return len(r.float64) + len(r.int64)
 }
 
-func (r observer) registerFloat64(id observablID[float64]) {
-	r.float64[id] = struct{}{}
+// dropped returns true if none of the registered instruments have
+// aggregators.
+func (r observer) dropped() bool {
+	for _, m := range r.float64 {
+		if len(m.load()) > 0 {
+			return false
+		}
+	}
+	for _, m := range r.int64 {
+		if len(m.load()) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (r observer) registerFloat64(id observablID[float64], m *measures[float64]) {
+	r.float64[id] = m
 }
 
-func (r observer) registerInt64(id observablID[int64]) {
-	r.int64[id] = struct{}{}
+func (r observer) registerInt64(id observablID[int64], m *measures[int64]) {
+	r.int64[id] = m
 }
 
 var (
@@ -445,7 +544,7 @@ func (noopRegister) Unregister() error {
 // int64InstProvider provides int64 OpenTelemetry instruments.
 type int64InstProvider struct{ *meter }
 
-func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]aggregate.Measure[int64], error) {
+func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string) (*measures[int64], error) {
 	inst := Instrument{
 		Name:        name,
 		Description: desc,
@@ -453,7 +552,9 @@ func (p int64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]ag
 		Kind:        kind,
 		Scope:       p.scope,
 	}
-	return p.int64Resolver.Aggregators(inst)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.int64Insts.resolve(p.int64Resolver, inst)
 }
 
 // lookup returns the resolved instrumentImpl.
@@ -465,7 +566,7 @@ func (p int64InstProvider) lookup(kind InstrumentKind, name, desc, u string) (*i
 // float64InstProvider provides float64 OpenTelemetry instruments.
 type float64InstProvider struct{ *meter }
 
-func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]aggregate.Measure[float64], error) {
+func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string) (*measures[float64], error) {
 	inst := Instrument{
 		Name:        name,
 		Description: desc,
@@ -473,7 +574,9 @@ func (p float64InstProvider) aggs(kind InstrumentKind, name, desc, u string) ([]
 		Kind:        kind,
 		Scope:       p.scope,
 	}
-	return p.float64Resolver.Aggregators(inst)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.float64Insts.resolve(p.float64Resolver, inst)
 }
 
 // lookup returns the resolved instrumentImpl.
@@ -490,8 +593,7 @@ func (p int64ObservProvider) lookup(kind InstrumentKind, name, desc, u string) (
 }
 
 func (p int64ObservProvider) registerCallbacks(inst int64Observable, cBacks []metric.Int64Callback) {
-	if inst.observable == nil || len(inst.measures) == 0 {
-		// Drop aggregator.
+	if inst.observable == nil {
 		return
 	}
 
@@ -502,7 +604,13 @@ func (p int64ObservProvider) registerCallbacks(inst int64Observable, cBacks []me
 
 func (p int64ObservProvider) callback(i int64Observable, f metric.Int64Callback) func(context.Context) error {
 	inst := int64Observer{int64Observable: i}
-	return func(ctx context.Context) error { return f(ctx, inst) }
+	return func(ctx context.Context) error {
+		if len(i.measures.load()) == 0 {
+			// Drop aggregator, until views are updated to aggregate i.
+			return nil
+		}
+		return f(ctx, inst)
+	}
 }
 
 type int64Observer struct {
@@ -523,8 +631,7 @@ func (p float64ObservProvider) lookup(kind InstrumentKind, name, desc, u string)
 }
 
 func (p float64ObservProvider) registerCallbacks(inst float64Observable, cBacks []metric.Float64Callback) {
-	if inst.observable == nil || len(inst.measures) == 0 {
-		// Drop aggregator.
+	if inst.observable == nil {
 		return
 	}
 
@@ -535,7 +642,13 @@ func (p float64ObservProvider) registerCallbacks(inst float64Observable, cBacks
 
 func (p float64ObservProvider) callback(i float64Observable, f metric.Float64Callback) func(context.Context) error {
 	inst := float64Observer{float64Observable: i}
-	return func(ctx context.Context) error { return f(ctx, inst) }
+	return func(ctx context.Context) error {
+		if len(i.measures.load()) == 0 {
+			// Drop aggregator, until views are updated to aggregate i.
+			return nil
+		}
+		return f(ctx, inst)
+	}
 }
 
 type float64Observer struct {
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata // import "go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+// Clone returns a deep copy of rm. The returned ResourceMetrics does not
+// share any slices with rm, it can be retained and modified without
+// affecting rm.
+//
+// The Resource and attribute sets are immutable and are shared.
+func (rm ResourceMetrics) Clone() ResourceMetrics {
+	rm.ScopeMetrics = cloneEach(rm.ScopeMetrics, ScopeMetrics.Clone)
+	return rm
+}
+
+// Clone returns a deep copy of sm.
+func (sm ScopeMetrics) Clone() ScopeMetrics {
+	sm.Metrics = cloneEach(sm.Metrics, Metrics.Clone)
+	return sm
+}
+
+// Clone returns a deep copy of m.
+func (m Metrics) Clone() Metrics {
+	switch a := m.Data.(type) {
+	case Gauge[int64]:
+		m.Data = a.Clone()
+	case Gauge[float64]:
+		m.Data = a.Clone()
+	case Sum[int64]:
+		m.Data = a.Clone()
+	case Sum[float64]:
+		m.Data = a.Clone()
+	case Histogram[int64]:
+		m.Data = a.Clone()
+	case Histogram[float64]:
+		m.Data = a.Clone()
+	case Summary[int64]:
+		m.Data = a.Clone()
+	case Summary[float64]:
+		m.Data = a.Clone()
+	case ExponentialHistogram[int64]:
+		m.Data = a.Clone()
+	case ExponentialHistogram[float64]:
+		m.Data = a.Clone()
+	}
+	return m
+}
+
+// Clone returns a deep copy of g.
+func (g Gauge[N]) Clone() Gauge[N] {
+	g.DataPoints = cloneEach(g.DataPoints, DataPoint[N].Clone)
+	return g
+}
+
+// Clone returns a deep copy of s.
+func (s Sum[N]) Clone() Sum[N] {
+	s.DataPoints = cloneEach(s.DataPoints, DataPoint[N].Clone)
+	return s
+}
+
+// Clone returns a deep copy of dp.
+func (dp DataPoint[N]) Clone() DataPoint[N] {
+	dp.Exemplars = cloneEach(dp.Exemplars, Exemplar[N].Clone)
+	return dp
+}
+
+// Clone returns a deep copy of h.
+func (h Histogram[N]) Clone() Histogram[N] {
+	h.DataPoints = cloneEach(h.DataPoints, HistogramDataPoint[N].Clone)
+	return h
+}
+
+// Clone returns a deep copy of dp.
+func (dp HistogramDataPoint[N]) Clone() HistogramDataPoint[N] {
+	dp.Bounds = cloneSlice(dp.Bounds)
+	dp.BucketCounts = cloneSlice(dp.BucketCounts)
+	dp.Exemplars = cloneEach(dp.Exemplars, Exemplar[N].Clone)
+	return dp
+}
+
+// Clone returns a deep copy of s.
+func (s Summary[N]) Clone() Summary[N] {
+	s.DataPoints = cloneSlice(s.DataPoints)
+	return s
+}
+
+// Clone returns a deep copy of h.
+func (h ExponentialHistogram[N]) Clone() ExponentialHistogram[N] {
+	h.DataPoints = cloneEach(h.DataPoints, ExponentialHistogramDataPoint[N].Clone)
+	return h
+}
+
+// Clone returns a deep copy of dp.
+func (dp ExponentialHistogramDataPoint[N]) Clone() ExponentialHistogramDataPoint[N] {
+	dp.PositiveBucket = dp.PositiveBucket.Clone()
+	dp.NegativeBucket = dp.NegativeBucket.Clone()
+	dp.Exemplars = cloneEach(dp.Exemplars, Exemplar[N].Clone)
+	return dp
+}
+
+// Clone returns a deep copy of b.
+func (b ExponentialBucket) Clone() ExponentialBucket {
+	b.Counts = cloneSlice(b.Counts)
+	return b
+}
+
+// Clone returns a deep copy of e.
+func (e Exemplar[N]) Clone() Exemplar[N] {
+	e.FilteredAttributes = cloneSlice(e.FilteredAttributes)
+	e.SpanID = cloneSlice(e.SpanID)
+	e.TraceID = cloneSlice(e.TraceID)
+	return e
+}
+
+// cloneSlice returns a copy of s. A nil s is returned as nil.
+func cloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+// cloneEach returns a copy of s with each element cloned by clone. A nil s
+// is returned as nil.
+func cloneEach[T any](s []T, clone func(T) T) []T {
+	if s == nil {
+		return nil
+	}
+	out := make([]T, len(s))
+	for i := range s {
+		out[i] = clone(s[i])
+	}
+	return out
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+var (
+	cloneResource = resource.NewSchemaless(attribute.String("service.name", "test"))
+	cloneTime     = time.Unix(1, 0)
+)
+
+func newResourceMetrics() ResourceMetrics {
+	attrs := attribute.NewSet(attribute.String("key", "value"))
+	exemplars := func() []Exemplar[int64] {
+		return []Exemplar[int64]{{
+			FilteredAttributes: []attribute.KeyValue{attribute.Int("filtered", 1)},
+			Time:               cloneTime,
+			Value:              2,
+			SpanID:             []byte{0, 0, 0, 0, 0, 0, 0, 1},
+			TraceID:            []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		}}
+	}
+	return ResourceMetrics{
+		Resource: cloneResource,
+		ScopeMetrics: []ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "scope"},
+			Metrics: []Metrics{
+				{
+					Name: "gauge",
+					Data: Gauge[float64]{DataPoints: []DataPoint[float64]{{Attributes: attrs, Value: 1}}},
+				},
+				{
+					Name: "sum",
+					Data: Sum[int64]{
+						DataPoints:  []DataPoint[int64]{{Attributes: attrs, Value: 2, Exemplars: exemplars()}},
+						Temporality: CumulativeTemporality,
+						IsMonotonic: true,
+					},
+				},
+				{
+					Name: "histogram",
+					Data: Histogram[int64]{
+						DataPoints: []HistogramDataPoint[int64]{{
+							Attributes:   attrs,
+							Count:        2,
+							Bounds:       []float64{1, 10},
+							BucketCounts: []uint64{0, 2, 0},
+							Min:          NewExtrema[int64](2),
+							Max:          NewExtrema[int64](2),
+							Sum:          4,
+							Exemplars:    exemplars(),
+						}},
+						Temporality: DeltaTemporality,
+					},
+				},
+				{
+					Name: "exponential histogram",
+					Data: ExponentialHistogram[int64]{
+						DataPoints: []ExponentialHistogramDataPoint[int64]{{
+							Attributes:     attrs,
+							Count:          3,
+							Sum:            6,
+							PositiveBucket: ExponentialBucket{Offset: 1, Counts: []uint64{1, 2}},
+							NegativeBucket: ExponentialBucket{Counts: []uint64{0}},
+							Exemplars:      exemplars(),
+						}},
+						Temporality: CumulativeTemporality,
+					},
+				},
+				{Name: "empty"},
+			},
+		}},
+	}
+}
+
+func TestResourceMetricsClone(t *testing.T) {
+	orig := newResourceMetrics()
+	clone := orig.Clone()
+	assert.Equal(t, newResourceMetrics(), clone)
+
+	// Modify every slice of the clone, none of it can be shared with orig.
+	clone.ScopeMetrics[0].Scope.Name = "modified"
+	ms := clone.ScopeMetrics[0].Metrics
+	ms[0].Data.(Gauge[float64]).DataPoints[0].Value = 100
+	sum := ms[1].Data.(Sum[int64])
+	sum.DataPoints[0].Value = 100
+	sum.DataPoints[0].Exemplars[0].Value = 100
+	sum.DataPoints[0].Exemplars[0].FilteredAttributes[0] = attribute.Int("filtered", 100)
+	sum.DataPoints[0].Exemplars[0].SpanID[0] = 100
+	sum.DataPoints[0].Exemplars[0].TraceID[0] = 100
+	hist := ms[2].Data.(Histogram[int64])
+	hist.DataPoints[0].Bounds[0] = 100
+	hist.DataPoints[0].BucketCounts[0] = 100
+	hist.DataPoints[0].Exemplars[0].Value = 100
+	expo := ms[3].Data.(ExponentialHistogram[int64])
+	expo.DataPoints[0].PositiveBucket.Counts[0] = 100
+	expo.DataPoints[0].NegativeBucket.Counts[0] = 100
+	expo.DataPoints[0].Exemplars[0].Value = 100
+	ms[4].Name = "modified"
+
+	assert.Equal(t, newResourceMetrics(), orig)
+}
+
+func TestCloneNil(t *testing.T) {
+	assert.Nil(t, ResourceMetrics{}.Clone().ScopeMetrics)
+	assert.Nil(t, Exemplar[int64]{}.Clone().SpanID)
+	assert.Nil(t, HistogramDataPoint[float64]{}.Clone().Bounds)
+	assert.Nil(t, ExponentialBucket{}.Clone().Counts)
+}
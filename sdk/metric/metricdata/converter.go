@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata // import "go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// TemporalityConverter converts successive collections of ResourceMetrics
+// of the same producer to a Temporality. It keeps the previous value of each
+// timeseries to compute the converted values.
+//
+// Sums and Histograms are converted. Converting to CumulativeTemporality
+// adds each delta to the previous cumulative value of its timeseries.
+// Converting to DeltaTemporality subtracts the previous cumulative value of
+// its timeseries. A timeseries is reset if its StartTime changes, if the
+// value of a monotonic Sum or the Count of a Histogram decreases, or if the
+// Bounds of a Histogram change. The Min and Max of Histograms converted to
+// DeltaTemporality are unknown and are not set.
+//
+// Gauges, Summaries, and ExponentialHistograms are not converted.
+//
+// The previous values of all timeseries are kept for the lifetime of the
+// TemporalityConverter. A TemporalityConverter is safe for concurrent use.
+type TemporalityConverter struct {
+	temporality Temporality
+
+	mu      sync.Mutex
+	streams map[streamID]any
+}
+
+// streamID identifies a timeseries.
+type streamID struct {
+	resource attribute.Distinct
+	scope    instrumentation.Scope
+	name     string
+	attrs    attribute.Distinct
+}
+
+// NewTemporalityConverter returns a TemporalityConverter that converts
+// ResourceMetrics to temporality.
+func NewTemporalityConverter(temporality Temporality) *TemporalityConverter {
+	return &TemporalityConverter{
+		temporality: temporality,
+		streams:     make(map[streamID]any),
+	}
+}
+
+// Convert returns a copy of rm with all Sums and Histograms converted to the
+// Temporality of c. The values of rm need to be collected after the values
+// previously passed to Convert.
+func (c *TemporalityConverter) Convert(rm ResourceMetrics) ResourceMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rm = rm.Clone()
+	var res attribute.Distinct
+	if rm.Resource != nil {
+		res = rm.Resource.Equivalent()
+	}
+	for i := range rm.ScopeMetrics {
+		sm := &rm.ScopeMetrics[i]
+		for j := range sm.Metrics {
+			m := &sm.Metrics[j]
+			id := streamID{resource: res, scope: sm.Scope, name: m.Name}
+			switch a := m.Data.(type) {
+			case Sum[int64]:
+				m.Data = convertSum(c, id, a)
+			case Sum[float64]:
+				m.Data = convertSum(c, id, a)
+			case Histogram[int64]:
+				m.Data = convertHistogram(c, id, a)
+			case Histogram[float64]:
+				m.Data = convertHistogram(c, id, a)
+			}
+		}
+	}
+	return rm
+}
+
+// sumState is the previous value of a Sum timeseries.
+type sumState[N int64 | float64] struct {
+	start, time time.Time
+	value       N
+}
+
+func convertSum[N int64 | float64](c *TemporalityConverter, id streamID, s Sum[N]) Sum[N] {
+	if s.Temporality == c.temporality {
+		return s
+	}
+	for i := range s.DataPoints {
+		dp := &s.DataPoints[i]
+		id.attrs = dp.Attributes.Equivalent()
+		prev, ok := c.streams[id].(sumState[N])
+
+		switch c.temporality {
+		case CumulativeTemporality:
+			if ok {
+				dp.StartTime = prev.start
+				dp.Value += prev.value
+			}
+			c.streams[id] = sumState[N]{start: dp.StartTime, time: dp.Time, value: dp.Value}
+		case DeltaTemporality:
+			cur := sumState[N]{start: dp.StartTime, time: dp.Time, value: dp.Value}
+			reset := !dp.StartTime.Equal(prev.start) || (s.IsMonotonic && dp.Value < prev.value)
+			if ok && !reset {
+				dp.StartTime = prev.time
+				dp.Value -= prev.value
+			}
+			c.streams[id] = cur
+		}
+	}
+	s.Temporality = c.temporality
+	return s
+}
+
+// histogramState is the previous value of a Histogram timeseries.
+type histogramState[N int64 | float64] struct {
+	start, time  time.Time
+	count        uint64
+	bounds       []float64
+	bucketCounts []uint64
+	sum          N
+	min, max     Extrema[N]
+}
+
+func newHistogramState[N int64 | float64](dp HistogramDataPoint[N]) histogramState[N] {
+	return histogramState[N]{
+		start:        dp.StartTime,
+		time:         dp.Time,
+		count:        dp.Count,
+		bounds:       cloneSlice(dp.Bounds),
+		bucketCounts: cloneSlice(dp.BucketCounts),
+		sum:          dp.Sum,
+		min:          dp.Min,
+		max:          dp.Max,
+	}
+}
+
+func convertHistogram[N int64 | float64](c *TemporalityConverter, id streamID, h Histogram[N]) Histogram[N] {
+	if h.Temporality == c.temporality {
+		return h
+	}
+	for i := range h.DataPoints {
+		dp := &h.DataPoints[i]
+		id.attrs = dp.Attributes.Equivalent()
+		prev, ok := c.streams[id].(histogramState[N])
+		ok = ok && reflect.DeepEqual(dp.Bounds, prev.bounds) && len(dp.BucketCounts) == len(prev.bucketCounts)
+
+		switch c.temporality {
+		case CumulativeTemporality:
+			if ok {
+				dp.StartTime = prev.start
+				dp.Count += prev.count
+				for j := range dp.BucketCounts {
+					dp.BucketCounts[j] += prev.bucketCounts[j]
+				}
+				dp.Sum += prev.sum
+				dp.Min = minExtrema(dp.Min, prev.min)
+				dp.Max = maxExtrema(dp.Max, prev.max)
+			}
+			c.streams[id] = newHistogramState(*dp)
+		case DeltaTemporality:
+			cur := newHistogramState(*dp)
+			if ok && dp.StartTime.Equal(prev.start) && dp.Count >= prev.count {
+				dp.StartTime = prev.time
+				dp.Count -= prev.count
+				for j := range dp.BucketCounts {
+					dp.BucketCounts[j] -= prev.bucketCounts[j]
+				}
+				dp.Sum -= prev.sum
+				dp.Min, dp.Max = Extrema[N]{}, Extrema[N]{}
+			}
+			c.streams[id] = cur
+		}
+	}
+	h.Temporality = c.temporality
+	return h
+}
+
+// minExtrema returns the smaller of the defined a and b.
+func minExtrema[N int64 | float64](a, b Extrema[N]) Extrema[N] {
+	av, aOk := a.Value()
+	bv, bOk := b.Value()
+	if !aOk || (bOk && bv < av) {
+		return b
+	}
+	return a
+}
+
+// maxExtrema returns the larger of the defined a and b.
+func maxExtrema[N int64 | float64](a, b Extrema[N]) Extrema[N] {
+	av, aOk := a.Value()
+	bv, bOk := b.Value()
+	if !aOk || (bOk && bv > av) {
+		return b
+	}
+	return a
+}
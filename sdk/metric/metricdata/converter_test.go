@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func sumRM[N int64 | float64](temporality Temporality, dPts ...DataPoint[N]) ResourceMetrics {
+	return ResourceMetrics{ScopeMetrics: []ScopeMetrics{{
+		Metrics: []Metrics{{Name: "sum", Data: Sum[N]{
+			Temporality: temporality,
+			IsMonotonic: true,
+			DataPoints:  dPts,
+		}}},
+	}}}
+}
+
+func histRM[N int64 | float64](temporality Temporality, dPts ...HistogramDataPoint[N]) ResourceMetrics {
+	return ResourceMetrics{ScopeMetrics: []ScopeMetrics{{
+		Metrics: []Metrics{{Name: "histogram", Data: Histogram[N]{
+			Temporality: temporality,
+			DataPoints:  dPts,
+		}}},
+	}}}
+}
+
+func TestTemporalityConverterSum(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("key", "value"))
+	t0, t1, t2, t3 := time.Unix(0, 0), time.Unix(1, 0), time.Unix(2, 0), time.Unix(3, 0)
+
+	t.Run("DeltaToCumulative", func(t *testing.T) {
+		c := NewTemporalityConverter(CumulativeTemporality)
+		got := c.Convert(sumRM(DeltaTemporality, DataPoint[int64]{Attributes: attrs, StartTime: t0, Time: t1, Value: 1}))
+		assert.Equal(t, sumRM(CumulativeTemporality, DataPoint[int64]{Attributes: attrs, StartTime: t0, Time: t1, Value: 1}), got)
+
+		got = c.Convert(sumRM(DeltaTemporality, DataPoint[int64]{Attributes: attrs, StartTime: t1, Time: t2, Value: 2}))
+		assert.Equal(t, sumRM(CumulativeTemporality, DataPoint[int64]{Attributes: attrs, StartTime: t0, Time: t2, Value: 3}), got)
+	})
+
+	t.Run("CumulativeToDelta", func(t *testing.T) {
+		c := NewTemporalityConverter(DeltaTemporality)
+		got := c.Convert(sumRM(CumulativeTemporality, DataPoint[float64]{Attributes: attrs, StartTime: t0, Time: t1, Value: 1}))
+		assert.Equal(t, sumRM(DeltaTemporality, DataPoint[float64]{Attributes: attrs, StartTime: t0, Time: t1, Value: 1}), got)
+
+		got = c.Convert(sumRM(CumulativeTemporality, DataPoint[float64]{Attributes: attrs, StartTime: t0, Time: t2, Value: 3}))
+		assert.Equal(t, sumRM(DeltaTemporality, DataPoint[float64]{Attributes: attrs, StartTime: t1, Time: t2, Value: 2}), got)
+
+		// A decreasing monotonic Sum is a reset.
+		got = c.Convert(sumRM(CumulativeTemporality, DataPoint[float64]{Attributes: attrs, StartTime: t0, Time: t3, Value: 2}))
+		assert.Equal(t, sumRM(DeltaTemporality, DataPoint[float64]{Attributes: attrs, StartTime: t0, Time: t3, Value: 2}), got)
+	})
+
+	t.Run("SameTemporality", func(t *testing.T) {
+		c := NewTemporalityConverter(DeltaTemporality)
+		rm := sumRM(DeltaTemporality, DataPoint[int64]{Attributes: attrs, Value: 1})
+		assert.Equal(t, rm, c.Convert(rm))
+		assert.Equal(t, rm, c.Convert(rm))
+	})
+}
+
+func TestTemporalityConverterHistogram(t *testing.T) {
+	t0, t1, t2 := time.Unix(0, 0), time.Unix(1, 0), time.Unix(2, 0)
+	dp := func(start, end time.Time, counts []uint64, sum, lo, hi int64) HistogramDataPoint[int64] {
+		var count uint64
+		for _, c := range counts {
+			count += c
+		}
+		return HistogramDataPoint[int64]{
+			StartTime:    start,
+			Time:         end,
+			Count:        count,
+			Bounds:       []float64{5},
+			BucketCounts: counts,
+			Min:          NewExtrema(lo),
+			Max:          NewExtrema(hi),
+			Sum:          sum,
+		}
+	}
+
+	c := NewTemporalityConverter(CumulativeTemporality)
+	c.Convert(histRM(DeltaTemporality, dp(t0, t1, []uint64{1, 0}, 2, 2, 2)))
+	got := c.Convert(histRM(DeltaTemporality, dp(t1, t2, []uint64{1, 1}, 10, 1, 9)))
+	assert.Equal(t, histRM(CumulativeTemporality, dp(t0, t2, []uint64{2, 1}, 12, 1, 9)), got)
+
+	c = NewTemporalityConverter(DeltaTemporality)
+	c.Convert(histRM(CumulativeTemporality, dp(t0, t1, []uint64{1, 0}, 2, 2, 2)))
+	got = c.Convert(histRM(CumulativeTemporality, dp(t0, t2, []uint64{2, 1}, 12, 1, 9)))
+	want := dp(t1, t2, []uint64{1, 1}, 10, 0, 0)
+	want.Min, want.Max = Extrema[int64]{}, Extrema[int64]{}
+	assert.Equal(t, histRM(DeltaTemporality, want), got)
+}
@@ -137,6 +137,40 @@ type HistogramDataPoint[N int64 | float64] struct {
 	Exemplars []Exemplar[N] `json:",omitempty"`
 }
 
+// Summary represents the count, sum, minimum, and maximum of all measurements
+// of values from an instrument. Unlike the Summary of the OpenTelemetry data
+// model, it does not contain quantiles.
+type Summary[N int64 | float64] struct {
+	// DataPoints are the individual aggregated measurements with unique
+	// Attributes.
+	DataPoints []SummaryDataPoint[N]
+	// Temporality describes if the aggregation is reported as the change from the
+	// last report time, or the cumulative changes since a fixed start time.
+	Temporality Temporality
+}
+
+func (Summary[N]) privateAggregation() {}
+
+// SummaryDataPoint is a single summary data point in a timeseries.
+type SummaryDataPoint[N int64 | float64] struct {
+	// Attributes is the set of key value pairs that uniquely identify the
+	// timeseries.
+	Attributes attribute.Set
+	// StartTime is when the timeseries was started.
+	StartTime time.Time
+	// Time is the time when the timeseries was recorded.
+	Time time.Time
+
+	// Count is the number of updates this summary has been calculated with.
+	Count uint64
+	// Min is the minimum value recorded. (optional)
+	Min Extrema[N]
+	// Max is the maximum value recorded. (optional)
+	Max Extrema[N]
+	// Sum is the sum of the values recorded.
+	Sum N
+}
+
 // ExponentialHistogram represents the histogram of all measurements of values from an instrument.
 type ExponentialHistogram[N int64 | float64] struct {
 	// DataPoints are the individual aggregated measurements with unique
@@ -0,0 +1,310 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata // import "go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	errResourceMismatch = errors.New("resources not equal")
+	errIncompatible     = errors.New("incompatible aggregations")
+	errConflict         = errors.New("conflicting data points")
+)
+
+// Merge returns the ResourceMetrics combining all rms. All rms need to have
+// an equal Resource, otherwise an error is returned.
+//
+// ScopeMetrics with equal Scopes are combined into one, and so are the
+// Metrics they contain with equal names. The data points of these Metrics
+// are combined by their attributes:
+//
+//   - Identical data points are deduplicated.
+//   - Data points of cumulative Sums are summed.
+//   - Data points of cumulative Histograms with equal Bounds are summed.
+//   - Data points of cumulative Summaries are summed.
+//
+// An error is returned if any other data points have equal attributes, or
+// if Metrics with equal names contain different types of Aggregations.
+//
+// The returned ResourceMetrics does not share any slices with rms.
+func Merge(rms ...ResourceMetrics) (ResourceMetrics, error) {
+	var out ResourceMetrics
+	for i, rm := range rms {
+		if i == 0 {
+			out.Resource = rm.Resource
+		} else if !out.Resource.Equal(rm.Resource) {
+			return ResourceMetrics{}, errResourceMismatch
+		}
+
+		rm = rm.Clone()
+		for _, sm := range rm.ScopeMetrics {
+			if err := mergeScopeMetrics(&out, sm); err != nil {
+				return ResourceMetrics{}, err
+			}
+		}
+	}
+	return out, nil
+}
+
+// mergeScopeMetrics combines sm into the ScopeMetrics of dst with an equal
+// Scope.
+func mergeScopeMetrics(dst *ResourceMetrics, sm ScopeMetrics) error {
+	i := 0
+	for ; i < len(dst.ScopeMetrics); i++ {
+		if dst.ScopeMetrics[i].Scope == sm.Scope {
+			break
+		}
+	}
+	if i == len(dst.ScopeMetrics) {
+		dst.ScopeMetrics = append(dst.ScopeMetrics, sm)
+		return nil
+	}
+
+	d := &dst.ScopeMetrics[i]
+	for _, m := range sm.Metrics {
+		j := 0
+		for ; j < len(d.Metrics); j++ {
+			if d.Metrics[j].Name == m.Name {
+				break
+			}
+		}
+		if j == len(d.Metrics) {
+			d.Metrics = append(d.Metrics, m)
+			continue
+		}
+		if err := mergeMetrics(&d.Metrics[j], m); err != nil {
+			return fmt.Errorf("scope %s metric %s: %w", sm.Scope.Name, m.Name, err)
+		}
+	}
+	return nil
+}
+
+// mergeMetrics combines the Aggregation of m into dst.
+func mergeMetrics(dst *Metrics, m Metrics) error {
+	if m.Data == nil {
+		return nil
+	}
+	if dst.Data == nil {
+		dst.Data = m.Data
+		return nil
+	}
+
+	var err error
+	switch a := dst.Data.(type) {
+	case Gauge[int64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeGauge[int64])
+	case Gauge[float64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeGauge[float64])
+	case Sum[int64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeSum[int64])
+	case Sum[float64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeSum[float64])
+	case Histogram[int64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeHistogram[int64])
+	case Histogram[float64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeHistogram[float64])
+	case Summary[int64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeSummary[int64])
+	case Summary[float64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeSummary[float64])
+	case ExponentialHistogram[int64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeExponentialHistogram[int64])
+	case ExponentialHistogram[float64]:
+		dst.Data, err = mergeAggregation(a, m.Data, mergeExponentialHistogram[float64])
+	default:
+		err = fmt.Errorf("%w: %T", errIncompatible, dst.Data)
+	}
+	return err
+}
+
+// mergeAggregation returns dst combined with src using merge. An error is
+// returned if src is not of the same type as dst.
+func mergeAggregation[A Aggregation](dst A, src Aggregation, merge func(A, A) (A, error)) (Aggregation, error) {
+	s, ok := src.(A)
+	if !ok {
+		return dst, fmt.Errorf("%w: %T and %T", errIncompatible, dst, src)
+	}
+	return merge(dst, s)
+}
+
+func mergeGauge[N int64 | float64](a, b Gauge[N]) (Gauge[N], error) {
+	var err error
+	a.DataPoints, err = mergeDataPoints(a.DataPoints, b.DataPoints, dataPointAttrs[N], nil)
+	return a, err
+}
+
+func mergeSum[N int64 | float64](a, b Sum[N]) (Sum[N], error) {
+	if a.Temporality != b.Temporality || a.IsMonotonic != b.IsMonotonic {
+		return a, fmt.Errorf("%w: %s and %s Sum", errIncompatible, a.Temporality, b.Temporality)
+	}
+	var combine func(DataPoint[N], DataPoint[N]) (DataPoint[N], error)
+	if a.Temporality == CumulativeTemporality {
+		combine = sumDataPoints[N]
+	}
+
+	var err error
+	a.DataPoints, err = mergeDataPoints(a.DataPoints, b.DataPoints, dataPointAttrs[N], combine)
+	return a, err
+}
+
+func mergeHistogram[N int64 | float64](a, b Histogram[N]) (Histogram[N], error) {
+	if a.Temporality != b.Temporality {
+		return a, fmt.Errorf("%w: %s and %s Histogram", errIncompatible, a.Temporality, b.Temporality)
+	}
+	var combine func(HistogramDataPoint[N], HistogramDataPoint[N]) (HistogramDataPoint[N], error)
+	if a.Temporality == CumulativeTemporality {
+		combine = sumHistogramDataPoints[N]
+	}
+
+	var err error
+	a.DataPoints, err = mergeDataPoints(a.DataPoints, b.DataPoints, histogramDataPointAttrs[N], combine)
+	return a, err
+}
+
+func mergeSummary[N int64 | float64](a, b Summary[N]) (Summary[N], error) {
+	if a.Temporality != b.Temporality {
+		return a, fmt.Errorf("%w: %s and %s Summary", errIncompatible, a.Temporality, b.Temporality)
+	}
+	var combine func(SummaryDataPoint[N], SummaryDataPoint[N]) (SummaryDataPoint[N], error)
+	if a.Temporality == CumulativeTemporality {
+		combine = sumSummaryDataPoints[N]
+	}
+
+	var err error
+	a.DataPoints, err = mergeDataPoints(a.DataPoints, b.DataPoints, summaryDataPointAttrs[N], combine)
+	return a, err
+}
+
+func mergeExponentialHistogram[N int64 | float64](a, b ExponentialHistogram[N]) (ExponentialHistogram[N], error) {
+	if a.Temporality != b.Temporality {
+		return a, fmt.Errorf("%w: %s and %s ExponentialHistogram", errIncompatible, a.Temporality, b.Temporality)
+	}
+
+	var err error
+	a.DataPoints, err = mergeDataPoints(a.DataPoints, b.DataPoints, exponentialHistogramDataPointAttrs[N], nil)
+	return a, err
+}
+
+// mergeDataPoints returns the data points of a and b combined by their
+// attributes. Identical data points are deduplicated, all other data points
+// with equal attributes are combined with combine. An error is returned if
+// combine is nil and data points need to be combined.
+func mergeDataPoints[T any](a, b []T, attrs func(T) attribute.Set, combine func(T, T) (T, error)) ([]T, error) {
+	key := func(dp T) attribute.Distinct {
+		s := attrs(dp)
+		return s.Equivalent()
+	}
+	idx := make(map[attribute.Distinct]int, len(a))
+	for i, dp := range a {
+		idx[key(dp)] = i
+	}
+	for _, dp := range b {
+		k := key(dp)
+		i, ok := idx[k]
+		if !ok {
+			idx[k] = len(a)
+			a = append(a, dp)
+			continue
+		}
+		if reflect.DeepEqual(a[i], dp) {
+			continue
+		}
+		if combine == nil {
+			s := attrs(dp)
+			return a, fmt.Errorf("%w: %s", errConflict, s.Encoded(attribute.DefaultEncoder()))
+		}
+
+		var err error
+		if a[i], err = combine(a[i], dp); err != nil {
+			return a, err
+		}
+	}
+	return a, nil
+}
+
+func dataPointAttrs[N int64 | float64](dp DataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func histogramDataPointAttrs[N int64 | float64](dp HistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func summaryDataPointAttrs[N int64 | float64](dp SummaryDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func exponentialHistogramDataPointAttrs[N int64 | float64](dp ExponentialHistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+// sumDataPoints returns the sum of the cumulative DataPoints a and b.
+func sumDataPoints[N int64 | float64](a, b DataPoint[N]) (DataPoint[N], error) {
+	a.Value += b.Value
+	if b.StartTime.Before(a.StartTime) {
+		a.StartTime = b.StartTime
+	}
+	if b.Time.After(a.Time) {
+		a.Time = b.Time
+	}
+	a.Exemplars = append(a.Exemplars, b.Exemplars...)
+	return a, nil
+}
+
+// sumSummaryDataPoints returns the sum of the cumulative SummaryDataPoints a
+// and b.
+func sumSummaryDataPoints[N int64 | float64](a, b SummaryDataPoint[N]) (SummaryDataPoint[N], error) {
+	a.Count += b.Count
+	a.Sum += b.Sum
+	a.Min = minExtrema(a.Min, b.Min)
+	a.Max = maxExtrema(a.Max, b.Max)
+	if b.StartTime.Before(a.StartTime) {
+		a.StartTime = b.StartTime
+	}
+	if b.Time.After(a.Time) {
+		a.Time = b.Time
+	}
+	return a, nil
+}
+
+// sumHistogramDataPoints returns the sum of the cumulative
+// HistogramDataPoints a and b. An error is returned if their Bounds are not
+// equal.
+func sumHistogramDataPoints[N int64 | float64](a, b HistogramDataPoint[N]) (HistogramDataPoint[N], error) {
+	if !reflect.DeepEqual(a.Bounds, b.Bounds) || len(a.BucketCounts) != len(b.BucketCounts) {
+		return a, fmt.Errorf("%w: Bounds %v and %v", errConflict, a.Bounds, b.Bounds)
+	}
+
+	a.Count += b.Count
+	for i := range a.BucketCounts {
+		a.BucketCounts[i] += b.BucketCounts[i]
+	}
+	a.Sum += b.Sum
+	a.Min = minExtrema(a.Min, b.Min)
+	a.Max = maxExtrema(a.Max, b.Max)
+	if b.StartTime.Before(a.StartTime) {
+		a.StartTime = b.StartTime
+	}
+	if b.Time.After(a.Time) {
+		a.Time = b.Time
+	}
+	a.Exemplars = append(a.Exemplars, b.Exemplars...)
+	return a, nil
+}
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestMerge(t *testing.T) {
+	attrA := attribute.NewSet(attribute.String("key", "A"))
+	attrB := attribute.NewSet(attribute.String("key", "B"))
+	start, end := time.Unix(1, 0), time.Unix(2, 0)
+
+	scopeA := instrumentation.Scope{Name: "A"}
+	scopeB := instrumentation.Scope{Name: "B"}
+	gauge := Metrics{Name: "gauge", Data: Gauge[int64]{
+		DataPoints: []DataPoint[int64]{{Attributes: attrA, Time: end, Value: 1}},
+	}}
+	sum := func(attrs attribute.Set, v int64, t time.Time) Metrics {
+		return Metrics{Name: "sum", Data: Sum[int64]{
+			Temporality: CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []DataPoint[int64]{{Attributes: attrs, StartTime: start, Time: t, Value: v}},
+		}}
+	}
+	hist := func(v float64) Metrics {
+		return Metrics{Name: "hist", Data: Histogram[float64]{
+			Temporality: CumulativeTemporality,
+			DataPoints: []HistogramDataPoint[float64]{{
+				Attributes:   attrA,
+				StartTime:    start,
+				Time:         end,
+				Count:        1,
+				Bounds:       []float64{1},
+				BucketCounts: []uint64{0, 1},
+				Min:          NewExtrema(v),
+				Max:          NewExtrema(v),
+				Sum:          v,
+			}},
+		}}
+	}
+
+	a := ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service.name", "test")),
+		ScopeMetrics: []ScopeMetrics{
+			{Scope: scopeA, Metrics: []Metrics{gauge, sum(attrA, 1, end), hist(2)}},
+		},
+	}
+	b := ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service.name", "test")),
+		ScopeMetrics: []ScopeMetrics{
+			{Scope: scopeA, Metrics: []Metrics{gauge, sum(attrA, 2, end.Add(time.Second)), sum(attrB, 3, end), hist(5)}},
+			{Scope: scopeB, Metrics: []Metrics{gauge}},
+		},
+	}
+
+	got, err := Merge(a, b)
+	require.NoError(t, err)
+
+	want := ResourceMetrics{
+		Resource: a.Resource,
+		ScopeMetrics: []ScopeMetrics{
+			{Scope: scopeA, Metrics: []Metrics{
+				gauge,
+				{Name: "sum", Data: Sum[int64]{
+					Temporality: CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []DataPoint[int64]{
+						{Attributes: attrA, StartTime: start, Time: end.Add(time.Second), Value: 3},
+						{Attributes: attrB, StartTime: start, Time: end, Value: 3},
+					},
+				}},
+				{Name: "hist", Data: Histogram[float64]{
+					Temporality: CumulativeTemporality,
+					DataPoints: []HistogramDataPoint[float64]{{
+						Attributes:   attrA,
+						StartTime:    start,
+						Time:         end,
+						Count:        2,
+						Bounds:       []float64{1},
+						BucketCounts: []uint64{0, 2},
+						Min:          NewExtrema[float64](2),
+						Max:          NewExtrema[float64](5),
+						Sum:          7,
+					}},
+				}},
+			}},
+			{Scope: scopeB, Metrics: []Metrics{gauge}},
+		},
+	}
+	assert.Equal(t, want, got)
+
+	// The inputs are not modified.
+	assert.Equal(t, int64(1), a.ScopeMetrics[0].Metrics[1].Data.(Sum[int64]).DataPoints[0].Value)
+}
+
+func TestMergeSummaries(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("key", "A"))
+	start, end := time.Unix(1, 0), time.Unix(2, 0)
+	rm := func(count uint64, minV, maxV, sum int64, t time.Time) ResourceMetrics {
+		return ResourceMetrics{ScopeMetrics: []ScopeMetrics{{
+			Metrics: []Metrics{{Name: "summary", Data: Summary[int64]{
+				Temporality: CumulativeTemporality,
+				DataPoints: []SummaryDataPoint[int64]{{
+					Attributes: attrs,
+					StartTime:  start,
+					Time:       t,
+					Count:      count,
+					Min:        NewExtrema(minV),
+					Max:        NewExtrema(maxV),
+					Sum:        sum,
+				}},
+			}}},
+		}}}
+	}
+
+	got, err := Merge(rm(2, 1, 5, 6, end), rm(1, 3, 9, 9, end.Add(time.Second)))
+	require.NoError(t, err)
+	assert.Equal(t, rm(3, 1, 9, 15, end.Add(time.Second)), got)
+}
+
+func TestMergeErrors(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("key", "A"))
+	rm := func(data Aggregation) ResourceMetrics {
+		return ResourceMetrics{ScopeMetrics: []ScopeMetrics{{
+			Metrics: []Metrics{{Name: "m", Data: data}},
+		}}}
+	}
+
+	_, err := Merge(
+		ResourceMetrics{Resource: resource.NewSchemaless(attribute.String("a", "a"))},
+		ResourceMetrics{Resource: resource.NewSchemaless(attribute.String("b", "b"))},
+	)
+	assert.ErrorIs(t, err, errResourceMismatch)
+
+	_, err = Merge(rm(Gauge[int64]{}), rm(Sum[int64]{}))
+	assert.ErrorIs(t, err, errIncompatible)
+
+	_, err = Merge(
+		rm(Sum[int64]{Temporality: CumulativeTemporality}),
+		rm(Sum[int64]{Temporality: DeltaTemporality}),
+	)
+	assert.ErrorIs(t, err, errIncompatible)
+
+	_, err = Merge(
+		rm(Gauge[int64]{DataPoints: []DataPoint[int64]{{Attributes: attrs, Value: 1}}}),
+		rm(Gauge[int64]{DataPoints: []DataPoint[int64]{{Attributes: attrs, Value: 2}}}),
+	)
+	assert.ErrorIs(t, err, errConflict)
+
+	_, err = Merge(
+		rm(Sum[int64]{Temporality: DeltaTemporality, DataPoints: []DataPoint[int64]{{Attributes: attrs, Value: 1}}}),
+		rm(Sum[int64]{Temporality: DeltaTemporality, DataPoints: []DataPoint[int64]{{Attributes: attrs, Value: 2}}}),
+	)
+	assert.ErrorIs(t, err, errConflict)
+
+	_, err = Merge(
+		rm(Histogram[int64]{Temporality: CumulativeTemporality, DataPoints: []HistogramDataPoint[int64]{{Attributes: attrs, Bounds: []float64{1}, BucketCounts: []uint64{1, 0}}}}),
+		rm(Histogram[int64]{Temporality: CumulativeTemporality, DataPoints: []HistogramDataPoint[int64]{{Attributes: attrs, Bounds: []float64{2}, BucketCounts: []uint64{1, 0}}}}),
+	)
+	assert.ErrorIs(t, err, errConflict)
+}
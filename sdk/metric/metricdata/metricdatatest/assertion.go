@@ -18,9 +18,12 @@ package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata
 
 import (
 	"fmt"
+	"path"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Datatypes are the concrete data-types the metricdata package provides.
@@ -33,6 +36,10 @@ type Datatypes interface {
 		metricdata.Histogram[int64] |
 		metricdata.HistogramDataPoint[float64] |
 		metricdata.HistogramDataPoint[int64] |
+		metricdata.Summary[float64] |
+		metricdata.Summary[int64] |
+		metricdata.SummaryDataPoint[float64] |
+		metricdata.SummaryDataPoint[int64] |
 		metricdata.Extrema[int64] |
 		metricdata.Extrema[float64] |
 		metricdata.Metrics |
@@ -67,9 +74,108 @@ type TestingT interface {
 }
 
 type config struct {
-	ignoreTimestamp bool
-	ignoreExemplars bool
-	ignoreValue     bool
+	ignoreTimestamp                  bool
+	ignoreZeroStartTime              bool
+	ignoreExemplars                  bool
+	ignoreExemplarFilteredAttributes bool
+	exemplarSubset                   bool
+	requireNonZeroTraceContext       bool
+	ignoreValue                      bool
+	ignoreTemporality                bool
+	ignoreMonotonicity               bool
+	ignoreScopeVersion               bool
+	ignoreHistogramSum               bool
+	ignoreHistogramMinMax            bool
+
+	// zeroAsUnset is used to consider a zero DataPoint value equal to any
+	// other value.
+	zeroAsUnset bool
+
+	// ignoreTrailingZeroBuckets is used to trim empty trailing histogram
+	// buckets, and their bounds, before comparison.
+	ignoreTrailingZeroBuckets bool
+
+	// numericTypeCoercion is used to compare int64 and float64 Gauges and
+	// Sums by converting both to float64.
+	numericTypeCoercion bool
+
+	// normalizeTemporality, if set, is the temporality Sums and Histograms
+	// are converted to before comparison.
+	normalizeTemporality metricdata.Temporality
+
+	// attributeKeys are the only data point attribute keys compared, if
+	// set. The ignoredAttributeKeys are data point attribute keys never
+	// compared. The attributeFilter is the Filter equivalent of both.
+	attributeKeys        map[attribute.Key]struct{}
+	ignoredAttributeKeys map[attribute.Key]struct{}
+	attributeFilter      attribute.Filter
+
+	// resourceAttributeKeys are the only Resource attribute keys compared,
+	// if set.
+	resourceAttributeKeys map[attribute.Key]struct{}
+
+	// metricPatterns, if set, are the glob patterns the name of a Metrics
+	// needs to match one of to be compared.
+	metricPatterns []string
+
+	// metricKey, if set, is used to pair the Metrics of ScopeMetrics before
+	// they are compared.
+	metricKey func(metricdata.Metrics) string
+
+	// valueComparer, if set, is used to compare numeric values.
+	valueComparer func(a, b float64) bool
+	// tolerance and relativeTolerance are the absolute and relative
+	// differences allowed between numeric values.
+	tolerance         float64
+	relativeTolerance float64
+	// bucketCountTolerance is the difference allowed between histogram
+	// bucket counts.
+	bucketCountTolerance uint64
+	// normalizeBucketCounts is used to compare bucket counts as fractions
+	// of the total count of their data point.
+	normalizeBucketCounts bool
+	// histogramDistributions is used to compare the values of
+	// HistogramDataPoints as distributions instead of field by field.
+	histogramDistributions bool
+
+	// orderedDataPoints is used to compare data points positionally instead
+	// of independent of their order.
+	orderedDataPoints bool
+
+	// subset is used to allow actual values to contain additional elements
+	// not in the expected values.
+	subset bool
+
+	// disallowDuplicateSeries is used to report data points of the same
+	// aggregation with equal attributes.
+	disallowDuplicateSeries bool
+
+	// diagnostics is used to append a summary of the mismatches by field
+	// to the reasons of a failed assertion.
+	diagnostics bool
+
+	// colorDiff is used to render reasons with colored expected and actual
+	// values.
+	colorDiff bool
+
+	// formatter, if set, is used to format the values reported as missing or
+	// unexpected.
+	formatter func(any) string
+
+	// diffFormat is the format the reasons of failed assertions are
+	// reported in.
+	diffFormat diffFormat
+
+	// scratch, if set, holds buffers reused between comparisons.
+	scratch *scratch
+
+	// usage, if set, records the options that made a difference to
+	// comparisons.
+	usage *OptionUsage
+
+	// updateGolden is used by AssertGolden to write the golden file instead
+	// of comparing against it.
+	updateGolden bool
 }
 
 func newConfig(opts []Option) config {
@@ -99,6 +205,20 @@ func IgnoreTimestamp() Option {
 	})
 }
 
+// IgnoreZeroStartTime disables checking if the StartTime of data points are
+// different when either StartTime is the zero time. This can be useful when
+// comparing with exporters that do not set a StartTime for data points
+// without a meaningful start, like those of a Gauge.
+//
+// StartTimes that are both set are still compared. Use IgnoreTimestamp to
+// ignore all timestamps.
+func IgnoreZeroStartTime() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreZeroStartTime = true
+		return cfg
+	})
+}
+
 // IgnoreExemplars disables checking if Exemplars are different.
 func IgnoreExemplars() Option {
 	return fnOption(func(cfg config) config {
@@ -107,6 +227,44 @@ func IgnoreExemplars() Option {
 	})
 }
 
+// IgnoreExemplarFilteredAttributes disables checking if the
+// FilteredAttributes of Exemplars are different. All other fields of
+// Exemplars are still checked.
+//
+// This option does not apply to AssertHasAttributes and HasAttributes, they
+// do not accept options. For an Exemplar, they only check its
+// FilteredAttributes, ignoring them would make the assertion always pass.
+func IgnoreExemplarFilteredAttributes() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreExemplarFilteredAttributes = true
+		return cfg
+	})
+}
+
+// RequireNonZeroExemplarTraceContext disables comparing the SpanID and
+// TraceID of Exemplars. Instead, the SpanID and TraceID of the actual
+// Exemplars are required to be set and not only contain zeros. This can be
+// useful to assert Exemplars are linked to a trace without depending on the
+// randomly generated IDs.
+func RequireNonZeroExemplarTraceContext() Option {
+	return fnOption(func(cfg config) config {
+		cfg.requireNonZeroTraceContext = true
+		return cfg
+	})
+}
+
+// ExemplarSubset allows the actual Exemplars of a data point to contain
+// Exemplars that are not in the expected Exemplars. Every expected Exemplar
+// still needs to be equal to an actual Exemplar. This can be useful to
+// assert that specific Exemplars were sampled without depending on the full
+// contents of a non-deterministic reservoir.
+func ExemplarSubset() Option {
+	return fnOption(func(cfg config) config {
+		cfg.exemplarSubset = true
+		return cfg
+	})
+}
+
 // IgnoreValue disables checking if values are different. This can be
 // useful for non-deterministic values, like measured durations.
 //
@@ -121,18 +279,461 @@ func IgnoreValue() Option {
 	})
 }
 
+// IgnoreTemporality disables checking if the Temporality of Sums, Histograms,
+// and ExponentialHistograms are different.
+//
+// This only skips the comparison of the Temporality field. It does not
+// reconcile delta and cumulative values, the values of data points are still
+// compared as is.
+func IgnoreTemporality() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreTemporality = true
+		return cfg
+	})
+}
+
+// IgnoreMonotonicity disables checking if the IsMonotonic field of Sums are
+// different.
+func IgnoreMonotonicity() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreMonotonicity = true
+		return cfg
+	})
+}
+
+// IgnoreScopeVersion disables checking if the Version of the instrumentation
+// Scope of ScopeMetrics are different. This can be useful for tests that
+// should not need an update each time the instrumentation version changes.
+//
+// The Name and SchemaURL of the Scope are still compared.
+func IgnoreScopeVersion() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreScopeVersion = true
+		return cfg
+	})
+}
+
+// IgnoreHistogramSum disables checking if the Sum of HistogramDataPoints and
+// ExponentialHistogramDataPoints are different. Unlike IgnoreValue, the
+// bucket counts are still compared. This can be useful when comparing
+// histograms from aggregators that do not track the sum.
+func IgnoreHistogramSum() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreHistogramSum = true
+		return cfg
+	})
+}
+
+// IgnoreHistogramMinMax disables checking if the Min and Max of
+// HistogramDataPoints and ExponentialHistogramDataPoints are different.
+// Unlike IgnoreValue, the bucket counts are still compared. This can be
+// useful when comparing histograms from aggregators that do not track the
+// extrema.
+func IgnoreHistogramMinMax() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreHistogramMinMax = true
+		return cfg
+	})
+}
+
+// TreatZeroAsUnset considers a DataPoint Value of zero to be unset, and
+// equal to any other Value. This applies to both the expected and actual
+// DataPoint. This can be useful to assert the shape of an aggregation
+// before all of its measurements have been recorded.
+//
+// This loosens the comparison: a DataPoint that should have a non-zero
+// Value is not reported if its Value is zero. It is not enabled by default
+// and should only be used when the Value cannot be known.
+func TreatZeroAsUnset() Option {
+	return fnOption(func(cfg config) config {
+		cfg.zeroAsUnset = true
+		return cfg
+	})
+}
+
+// IgnoreTrailingZeroBuckets disables checking trailing empty buckets of
+// HistogramDataPoints. This can be useful when comparing histograms from
+// views configured with different explicit bucket boundaries that observed
+// the same measurements.
+//
+// Trailing buckets with a count of zero, and the bounds that start them, are
+// removed from the expected and actual values independently before they are
+// compared. Non-zero buckets are never removed. This means histograms that
+// differ only in the upper bounds of their unused buckets are considered
+// equal, even though they do not describe the same bucket layout.
+func IgnoreTrailingZeroBuckets() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreTrailingZeroBuckets = true
+		return cfg
+	})
+}
+
+// WithNumericTypeCoercion allows a Gauge or Sum of int64 values to be
+// compared with a Gauge or Sum of float64 values. The int64 values, including
+// the values of Exemplars, are converted to float64 before they are compared.
+// All other fields are still compared as is. This can be useful when testing
+// the migration of an instrument from one number type to the other.
+//
+// An int64 with a magnitude greater than 2^53 cannot be exactly represented
+// as a float64. These values are rounded during conversion, which means
+// distinct int64 values can be reported as equal to the same float64 value.
+func WithNumericTypeCoercion() Option {
+	return fnOption(func(cfg config) config {
+		cfg.numericTypeCoercion = true
+		return cfg
+	})
+}
+
+// DisallowDuplicateSeries reports an error if the DataPoints of a Gauge,
+// Sum, Histogram, or ExponentialHistogram contain more than one data point
+// with equal Attributes. Both the expected and actual values are checked.
+//
+// By default, duplicate series are matched one-to-one with the other value
+// and are not reported.
+func DisallowDuplicateSeries() Option {
+	return fnOption(func(cfg config) config {
+		cfg.disallowDuplicateSeries = true
+		return cfg
+	})
+}
+
+// MatchAttributeKeys restricts the comparison of data point attributes to
+// only the attributes with the passed keys. All other attributes are ignored.
+// This can be useful when most attributes are non-deterministic and only a
+// few are relevant to what is being tested.
+//
+// If MatchAttributeKeys is passed multiple times, attributes with any of the
+// passed keys are compared.
+func MatchAttributeKeys(keys ...attribute.Key) Option {
+	return fnOption(func(cfg config) config {
+		matched := make(map[attribute.Key]struct{}, len(cfg.attributeKeys)+len(keys))
+		for k := range cfg.attributeKeys {
+			matched[k] = struct{}{}
+		}
+		for _, k := range keys {
+			matched[k] = struct{}{}
+		}
+		cfg.attributeKeys = matched
+		cfg.attributeFilter = newAttributeFilter(cfg)
+		return cfg
+	})
+}
+
+// IgnoreAttributeKeys excludes the data point attributes with the passed
+// keys from the comparison. All other attributes are still compared. This
+// can be useful to ignore volatile attributes, like a host name or process
+// ID.
+//
+// If IgnoreAttributeKeys is passed multiple times, attributes with any of
+// the passed keys are ignored. Attributes are ignored even if their key is
+// passed to MatchAttributeKeys.
+func IgnoreAttributeKeys(keys ...attribute.Key) Option {
+	return fnOption(func(cfg config) config {
+		ignored := make(map[attribute.Key]struct{}, len(cfg.ignoredAttributeKeys)+len(keys))
+		for k := range cfg.ignoredAttributeKeys {
+			ignored[k] = struct{}{}
+		}
+		for _, k := range keys {
+			ignored[k] = struct{}{}
+		}
+		cfg.ignoredAttributeKeys = ignored
+		cfg.attributeFilter = newAttributeFilter(cfg)
+		return cfg
+	})
+}
+
+// newAttributeFilter returns the Filter of the data point attribute keys
+// compared based on cfg. If cfg compares all keys, nil is returned.
+func newAttributeFilter(cfg config) attribute.Filter {
+	matched, ignored := cfg.attributeKeys, cfg.ignoredAttributeKeys
+	if matched == nil && ignored == nil {
+		return nil
+	}
+	return func(kv attribute.KeyValue) bool {
+		if _, ok := ignored[kv.Key]; ok {
+			return false
+		}
+		if matched == nil {
+			return true
+		}
+		_, ok := matched[kv.Key]
+		return ok
+	}
+}
+
+// WithResourceAttributeKeys restricts the comparison of the Resource of
+// ResourceMetrics to only the attributes with the passed keys and the schema
+// URL. All other Resource attributes are ignored. This can be useful when the
+// Resource contains attributes that vary between environments, like host and
+// process attributes.
+//
+// If WithResourceAttributeKeys is passed multiple times, attributes with any
+// of the passed keys are compared.
+func WithResourceAttributeKeys(keys ...attribute.Key) Option {
+	return fnOption(func(cfg config) config {
+		matched := make(map[attribute.Key]struct{}, len(cfg.resourceAttributeKeys)+len(keys))
+		for k := range cfg.resourceAttributeKeys {
+			matched[k] = struct{}{}
+		}
+		for _, k := range keys {
+			matched[k] = struct{}{}
+		}
+		cfg.resourceAttributeKeys = matched
+		return cfg
+	})
+}
+
+// OnlyMetrics compares only the Metrics with a name matching pattern. All
+// other Metrics of compared ScopeMetrics are ignored, they are neither
+// compared nor reported as missing or unexpected. This can be useful to
+// assert only the relevant Metrics of a scope that contains unrelated
+// instruments.
+//
+// The pattern has the syntax of path.Match, for example "http.server.*".
+// OnlyMetrics panics if pattern is malformed. If OnlyMetrics is passed
+// multiple times, Metrics matching any of the patterns are compared.
+func OnlyMetrics(pattern string) Option {
+	if _, err := path.Match(pattern, ""); err != nil {
+		panic(fmt.Sprintf("invalid OnlyMetrics pattern %q: %v", pattern, err))
+	}
+	return fnOption(func(cfg config) config {
+		cfg.metricPatterns = append(cfg.metricPatterns[:len(cfg.metricPatterns):len(cfg.metricPatterns)], pattern)
+		return cfg
+	})
+}
+
+// WithMetricKey pairs the Metrics of compared ScopeMetrics by the key
+// returned from key, and then compares each pair field by field. This
+// produces a targeted diff of the Metrics that correspond to each other
+// instead of reporting one as missing and the other as unexpected. For
+// example, to pair Metrics by their Name:
+//
+//	WithMetricKey(func(m metricdata.Metrics) string { return m.Name })
+//
+// Each expected Metrics is paired with the first unpaired actual Metrics with
+// the same key. Metrics that cannot be paired are reported as missing or
+// unexpected.
+//
+// By default, Metrics are matched by their equality and are not paired.
+func WithMetricKey(key func(metricdata.Metrics) string) Option {
+	return fnOption(func(cfg config) config {
+		cfg.metricKey = key
+		return cfg
+	})
+}
+
+// WithTolerance allows numeric values to differ by at most epsilon and still
+// be considered equal. This can be useful for values subject to floating
+// point imprecision.
+//
+// The tolerance is applied to the value of DataPoints and Exemplars, the
+// sum, min, and max of HistogramDataPoints and
+// ExponentialHistogramDataPoints, and float attribute values. If used with
+// WithRelativeTolerance, values are equal if they are within either
+// tolerance.
+func WithTolerance(epsilon float64) Option {
+	return fnOption(func(cfg config) config {
+		cfg.tolerance = epsilon
+		return cfg
+	})
+}
+
+// WithRelativeTolerance allows numeric values to differ by at most fraction
+// of the larger of their magnitudes and still be considered equal. That is,
+// a and b are equal if
+//
+//	|a-b| <= fraction * max(|a|, |b|)
+//
+// This can be useful for values that span many orders of magnitude, where an
+// absolute tolerance is not meaningful. A value of zero is only equal to
+// zero, unless the values are within the tolerance of WithTolerance.
+//
+// The tolerance is applied to the same values as WithTolerance. If used with
+// WithTolerance, values are equal if they are within either tolerance.
+func WithRelativeTolerance(fraction float64) Option {
+	return fnOption(func(cfg config) config {
+		cfg.relativeTolerance = fraction
+		return cfg
+	})
+}
+
+// WithBucketCountTolerance allows each histogram bucket count to differ by
+// at most n and still be considered equal. This can be useful for sampling
+// based tests where measurements near a bucket boundary can be counted in
+// either bucket.
+//
+// The tolerance is applied to the BucketCounts of HistogramDataPoints and
+// the Counts of ExponentialBuckets. By default, bucket counts need to be
+// exactly equal.
+func WithBucketCountTolerance(n uint64) Option {
+	return fnOption(func(cfg config) config {
+		cfg.bucketCountTolerance = n
+		return cfg
+	})
+}
+
+// NormalizeBucketCounts compares the shape of histogram distributions
+// instead of their absolute counts. This can be useful to compare histograms
+// of a different number of measurements, like a downsampled run and a full
+// run.
+//
+// Each bucket count is divided by the Count of its data point and the
+// resulting fractions are compared using WithTolerance or
+// WithRelativeTolerance. The Count and Sum of data points are not compared.
+// A data point with a Count of zero has all its fractions equal to zero.
+//
+// The BucketCounts of HistogramDataPoints, and the ZeroCount and bucket
+// Counts of ExponentialHistogramDataPoints are normalized. ExponentialBuckets
+// compared on their own are normalized by the sum of their Counts.
+// WithBucketCountTolerance has no effect when bucket counts are normalized.
+func NormalizeBucketCounts() Option {
+	return fnOption(func(cfg config) config {
+		cfg.normalizeBucketCounts = true
+		return cfg
+	})
+}
+
+// WithValueComparer sets the function used to determine if two numeric
+// values are equal. Both values are converted to float64 before being passed
+// to equal. This can be useful for values with non-standard equality
+// semantics, like fixed-point values that are equal after quantization.
+//
+// The comparer is used for the value of DataPoints and Exemplars, and the
+// sum, min, and max of HistogramDataPoints and
+// ExponentialHistogramDataPoints. If set, it takes precedence over
+// WithTolerance and WithRelativeTolerance.
+func WithValueComparer(equal func(a, b float64) bool) Option {
+	return fnOption(func(cfg config) config {
+		cfg.valueComparer = equal
+		return cfg
+	})
+}
+
 // AssertEqual asserts that the two concrete data-types from the metricdata
 // package are equal.
+//
+// Data points, and all other elements contained in a slice, are compared
+// independent of the order they are stored in. Use AssertEqualOrdered to
+// also compare the order of data points.
+//
+// Individual data points, like a DataPoint or a HistogramDataPoint, are
+// Datatypes themselves and can be compared directly without wrapping them in
+// an aggregation.
 func AssertEqual[T Datatypes](t TestingT, expected, actual T, opts ...Option) bool {
 	t.Helper()
 
 	cfg := newConfig(opts)
+	if r := equalDatatypes(expected, actual, cfg); len(r) > 0 {
+		t.Error(cfg.failure(expected, actual, r))
+		return false
+	}
+	return true
+}
+
+// AssertNotEqual asserts that the two concrete data-types from the
+// metricdata package are not equal. The comparison is the same as
+// AssertEqual, including the handling of opts.
+func AssertNotEqual[T Datatypes](t TestingT, a, b T, opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+	if r := equalDatatypes(a, b, cfg); len(r) == 0 {
+		t.Error([]string{fmt.Sprintf("values are unexpectedly equal:\n%s", cfg.format(a))})
+		return false
+	}
+	return true
+}
+
+// AssertEqualResourceMetrics asserts that the two ResourceMetrics are equal.
+// It is the same as AssertEqual, but does not require type inference.
+func AssertEqualResourceMetrics(t TestingT, expected, actual metricdata.ResourceMetrics, opts ...Option) bool {
+	t.Helper()
+	return AssertEqual(t, expected, actual, opts...)
+}
+
+// AssertEqualScopeMetrics asserts that the two ScopeMetrics are equal. It is
+// the same as AssertEqual, but does not require type inference.
+func AssertEqualScopeMetrics(t TestingT, expected, actual metricdata.ScopeMetrics, opts ...Option) bool {
+	t.Helper()
+	return AssertEqual(t, expected, actual, opts...)
+}
+
+// AssertEqualMetrics asserts that the two Metrics are equal. It is the same
+// as AssertEqual, but does not require type inference.
+func AssertEqualMetrics(t TestingT, expected, actual metricdata.Metrics, opts ...Option) bool {
+	t.Helper()
+	return AssertEqual(t, expected, actual, opts...)
+}
+
+// WithOrderedCompare returns an option that compares data points
+// positionally instead of independent of their order. Failures report the
+// index of the first data points that are not equal.
+//
+// Comparing positionally is linear in the number of data points, where
+// the default comparison is quadratic. All other elements (i.e.
+// ScopeMetrics, Metrics, and Exemplars) are still compared independent of
+// their order.
+func WithOrderedCompare() Option {
+	return fnOption(func(cfg config) config {
+		cfg.orderedDataPoints = true
+		return cfg
+	})
+}
+
+// AssertEqualOrdered asserts that the two concrete data-types from the
+// metricdata package are equal, including the order of their data points.
+//
+// This is the same as AssertEqual with the WithOrderedCompare option.
+func AssertEqualOrdered[T Datatypes](t TestingT, expected, actual T, opts ...Option) bool {
+	t.Helper()
+	return AssertEqual(t, expected, actual, append([]Option{WithOrderedCompare()}, opts...)...)
+}
+
+// AssertContains asserts that actual contains expected. It passes when
+// every element of expected is equal to some element of actual. Additional
+// elements of actual are not reported, at any level of nesting (i.e.
+// ScopeMetrics, Metrics, DataPoints, and Exemplars).
+//
+// This is commonly used to assert the ResourceMetrics or ScopeMetrics
+// collected from an instrumented library contain the metrics a test emits,
+// ignoring all other metrics.
+func AssertContains[T Datatypes](t TestingT, expected, actual T, opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+	cfg.subset = true
+	if r := equalDatatypes(expected, actual, cfg); len(r) > 0 {
+		t.Error(cfg.failure(expected, actual, r))
+		return false
+	}
+	return true
+}
 
+// equalDatatypes returns reasons the two concrete data-types from the
+// metricdata package are not equal. If they are equal, the returned reasons
+// will be empty.
+func equalDatatypes[T Datatypes](expected, actual T, cfg config) []string {
 	// Generic types cannot be type asserted. Use an interface instead.
-	aIface := interface{}(actual)
+	r, ok := equalIfaces(interface{}(expected), interface{}(actual), cfg)
+	if !ok {
+		// We control all types passed to this, panic to signal developers
+		// early they changed things in an incompatible way.
+		panic(fmt.Sprintf("unknown types: %T", expected))
+	}
+	if cfg.usage != nil {
+		cfg.usage.record(expected, actual, cfg, r)
+	}
+	return r
+}
+
+// equalIfaces returns reasons expected and actual are not equal, and true.
+// Both expected and actual need to hold the same Datatypes type. If they do
+// not hold a known type, false is returned.
+func equalIfaces(expected, actual interface{}, cfg config) ([]string, bool) {
+	aIface := actual
 
 	var r []string
-	switch e := interface{}(expected).(type) {
+	switch e := expected.(type) {
 	case metricdata.Exemplar[int64]:
 		r = equalExemplars(e, aIface.(metricdata.Exemplar[int64]), cfg)
 	case metricdata.Exemplar[float64]:
@@ -153,6 +754,14 @@ func AssertEqual[T Datatypes](t TestingT, expected, actual T, opts ...Option) bo
 		r = equalHistogramDataPoints(e, aIface.(metricdata.HistogramDataPoint[float64]), cfg)
 	case metricdata.HistogramDataPoint[int64]:
 		r = equalHistogramDataPoints(e, aIface.(metricdata.HistogramDataPoint[int64]), cfg)
+	case metricdata.Summary[float64]:
+		r = equalSummaries(e, aIface.(metricdata.Summary[float64]), cfg)
+	case metricdata.Summary[int64]:
+		r = equalSummaries(e, aIface.(metricdata.Summary[int64]), cfg)
+	case metricdata.SummaryDataPoint[float64]:
+		r = equalSummaryDataPoints(e, aIface.(metricdata.SummaryDataPoint[float64]), cfg)
+	case metricdata.SummaryDataPoint[int64]:
+		r = equalSummaryDataPoints(e, aIface.(metricdata.SummaryDataPoint[int64]), cfg)
 	case metricdata.Extrema[int64]:
 		r = equalExtrema(e, aIface.(metricdata.Extrema[int64]), cfg)
 	case metricdata.Extrema[float64]:
@@ -178,16 +787,51 @@ func AssertEqual[T Datatypes](t TestingT, expected, actual T, opts ...Option) bo
 	case metricdata.ExponentialBucket:
 		r = equalExponentialBuckets(e, aIface.(metricdata.ExponentialBucket), cfg)
 	default:
-		// We control all types passed to this, panic to signal developers
-		// early they changed things in an incompatible way.
-		panic(fmt.Sprintf("unknown types: %T", expected))
+		return nil, false
 	}
+	return r, true
+}
 
-	if len(r) > 0 {
-		t.Error(r)
-		return false
+// Equal returns if the two concrete data-types from the metricdata package
+// are equal, and the reasons they are not if they are not equal. The
+// comparison is the same as AssertEqual.
+//
+// This can be used to build assertions or matchers for other testing
+// frameworks.
+func Equal[T Datatypes](expected, actual T, opts ...Option) (equal bool, reasons []string) {
+	reasons = equalDatatypes(expected, actual, newConfig(opts))
+	return len(reasons) == 0, reasons
+}
+
+// Explain returns the failure message AssertEqual reports if the two concrete
+// data-types from the metricdata package are not equal. If they are equal,
+// an empty string is returned. The comparison is the same as AssertEqual.
+//
+// The returned message is the same text a *testing.T logs for a failed
+// AssertEqual. This can be used to test wrappers of AssertEqual or to write
+// the failure to a custom sink.
+func Explain[T Datatypes](expected, actual T, opts ...Option) string {
+	cfg := newConfig(opts)
+	r := equalDatatypes(expected, actual, cfg)
+	if len(r) == 0 {
+		return ""
 	}
-	return true
+	return fmt.Sprint(cfg.failure(expected, actual, r))
+}
+
+// EqualAggregation returns if the two Aggregations are equal, and the
+// reasons they are not if they are not equal. The comparison is the same as
+// AssertAggregationsEqual.
+func EqualAggregation(expected, actual metricdata.Aggregation, opts ...Option) (equal bool, reasons []string) {
+	reasons = equalAggregations(expected, actual, newConfig(opts))
+	return len(reasons) == 0, reasons
+}
+
+// EqualExemplar returns if the two Exemplars are equal, and the reasons they
+// are not if they are not equal. The comparison is the same as AssertEqual.
+func EqualExemplar[N int64 | float64](expected, actual metricdata.Exemplar[N], opts ...Option) (equal bool, reasons []string) {
+	reasons = equalExemplars(expected, actual, newConfig(opts))
+	return len(reasons) == 0, reasons
 }
 
 // AssertAggregationsEqual asserts that two Aggregations are equal.
@@ -196,19 +840,284 @@ func AssertAggregationsEqual(t TestingT, expected, actual metricdata.Aggregation
 
 	cfg := newConfig(opts)
 	if r := equalAggregations(expected, actual, cfg); len(r) > 0 {
+		t.Error(cfg.failure(expected, actual, r))
+		return false
+	}
+	return true
+}
+
+// AssertMonotonicIncreasing asserts that curr is a valid successor of prev
+// for a cumulative monotonic Sum. Both prev and curr need to be monotonic and
+// cumulative, and the value of each data point in curr needs to be greater
+// than or equal to the value of the data point in prev with the same
+// attributes. Data points that only exist in one of prev or curr are not
+// checked.
+func AssertMonotonicIncreasing[N int64 | float64](t TestingT, prev, curr metricdata.Sum[N]) bool {
+	t.Helper()
+
+	if r := monotonicIncreasing(prev, curr); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// AssertExponentialHistogramValid asserts that dp is structurally valid. The
+// ZeroCount and the bucket counts need to add up to the Count, the Scale
+// needs to be within [-10, 20], the index of the last bucket of each
+// ExponentialBucket cannot overflow an int32, and Min cannot be greater than
+// Max when both are set. Each violation is reported as a separate failure.
+func AssertExponentialHistogramValid[N int64 | float64](t TestingT, dp metricdata.ExponentialHistogramDataPoint[N]) bool {
+	t.Helper()
+
+	if r := validExponentialHistogram(dp); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// AssertDataPointValue asserts that agg contains exactly one data point with
+// attributes equal to attrs, and that its value is equal to want. The agg
+// needs to be a Gauge or Sum with the same number type as want.
+//
+// The value comparison honors the WithValueComparer, WithTolerance, and
+// WithRelativeTolerance options.
+func AssertDataPointValue[N int64 | float64](t TestingT, agg metricdata.Aggregation, attrs attribute.Set, want N, opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+	if r := dataPointValue(agg, attrs, want, cfg); len(r) > 0 {
+		t.Error(cfg.render(r))
+		return false
+	}
+	return true
+}
+
+// AssertTimestampsWithin asserts that the Time of all data points of rm, and
+// their StartTime if it is not zero, are within [start, end]. This can be
+// used to verify timestamps that are otherwise ignored with IgnoreTimestamp.
+func AssertTimestampsWithin(t TestingT, rm metricdata.ResourceMetrics, start, end time.Time) bool {
+	t.Helper()
+
+	if r := timestampsWithinResourceMetrics(rm, start, end); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// AssertSumValues asserts that the data points of sum correspond exactly to
+// want. Each entry of want needs to have one data point with equal attributes
+// and value, and sum cannot contain any other data points. All other fields
+// of the data points are not checked.
+//
+// The value comparison honors the WithValueComparer, WithTolerance, and
+// WithRelativeTolerance options.
+func AssertSumValues[N int64 | float64](t TestingT, sum metricdata.Sum[N], want map[attribute.Set]N, opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+	if r := dataPointValues(sum.DataPoints, want, cfg); len(r) > 0 {
+		t.Error(cfg.render(r))
+		return false
+	}
+	return true
+}
+
+// AssertGaugeValues asserts that the data points of gauge correspond exactly
+// to want. The assertion is the same as AssertSumValues.
+func AssertGaugeValues[N int64 | float64](t TestingT, gauge metricdata.Gauge[N], want map[attribute.Set]N, opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+	if r := dataPointValues(gauge.DataPoints, want, cfg); len(r) > 0 {
+		t.Error(cfg.render(r))
+		return false
+	}
+	return true
+}
+
+// AssertExemplarCount asserts that dp contains want Exemplars.
+func AssertExemplarCount[N int64 | float64](t TestingT, dp metricdata.DataPoint[N], want int) bool {
+	t.Helper()
+
+	if r := exemplarCount("DataPoint", dp.Attributes, len(dp.Exemplars), want); len(r) > 0 {
 		t.Error(r)
 		return false
 	}
 	return true
 }
 
+// AssertHistogramExemplarCount asserts that dp contains want Exemplars.
+func AssertHistogramExemplarCount[N int64 | float64](t TestingT, dp metricdata.HistogramDataPoint[N], want int) bool {
+	t.Helper()
+
+	if r := exemplarCount("HistogramDataPoint", dp.Attributes, len(dp.Exemplars), want); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// AssertExponentialHistogramExemplarCount asserts that dp contains want
+// Exemplars.
+func AssertExponentialHistogramExemplarCount[N int64 | float64](t TestingT, dp metricdata.ExponentialHistogramDataPoint[N], want int) bool {
+	t.Helper()
+
+	if r := exemplarCount("ExponentialHistogramDataPoint", dp.Attributes, len(dp.Exemplars), want); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// AssertHasExemplar asserts that exemplars contains an Exemplar equal to
+// want. The Exemplars are compared the same as by AssertEqual with opts.
+func AssertHasExemplar[N int64 | float64](t TestingT, exemplars []metricdata.Exemplar[N], want metricdata.Exemplar[N], opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(opts)
+	if r := hasExemplar(exemplars, want, cfg); len(r) > 0 {
+		t.Error(cfg.render(r))
+		return false
+	}
+	return true
+}
+
+// AssertExemplarsLinkTrace asserts that exemplars contains an Exemplar
+// recorded in the span with traceID and spanID.
+func AssertExemplarsLinkTrace[N int64 | float64](t TestingT, exemplars []metricdata.Exemplar[N], traceID trace.TraceID, spanID trace.SpanID) bool {
+	t.Helper()
+
+	if r := exemplarsLinkTrace(exemplars, traceID, spanID); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// CompareHistogramDistributions compares the values of HistogramDataPoints
+// as distributions instead of requiring equal Bounds and BucketCounts. This
+// can be useful when a view changes the bucket layout of a histogram, but the
+// measurements it describes are the same.
+//
+// This applies the comparison of AssertHistogramDistributionEqual to all
+// HistogramDataPoints compared. Combine it with NormalizeBucketCounts to
+// compare the cumulative counts as fractions of the data point Count. The
+// Attributes, timestamps, and Exemplars of the data points are still
+// compared. ExponentialHistogramDataPoints are not affected.
+func CompareHistogramDistributions() Option {
+	return fnOption(func(cfg config) config {
+		cfg.histogramDistributions = true
+		return cfg
+	})
+}
+
+// AssertHistogramDistributionEqual asserts that expected and actual describe
+// an equivalent distribution of measurements, even if they use different
+// Bounds. This can be useful when testing a migration from one set of
+// explicit bucket boundaries to another.
+//
+// The Count, Sum, Min, and Max of the data points are compared the same as
+// AssertEqual. The buckets are compared using the cumulative count at each
+// boundary contained in both Bounds: both histograms need to have the same
+// number of measurements less than or equal to each shared boundary. Bounds
+// that are only contained in one of the histograms are not compared.
+//
+// This is an approximate comparison of what the histograms observed. Unlike
+// AssertEqual, it does not check the Bounds, BucketCounts, Attributes,
+// timestamps, or Exemplars are equal. Use AssertEqual with the
+// CompareHistogramDistributions option to compare the distributions of
+// whole Histograms, including these fields.
+func AssertHistogramDistributionEqual[N int64 | float64](t TestingT, expected, actual metricdata.HistogramDataPoint[N], opts ...Option) bool {
+	t.Helper()
+
+	cfg := newConfig(append([]Option{CompareHistogramDistributions()}, opts...))
+	if r := equalHistogramDistributions(expected, actual, cfg); len(r) > 0 {
+		t.Error(cfg.render(r))
+		return false
+	}
+	return true
+}
+
+// AssertEmpty asserts that agg does not contain any data points. The agg
+// needs to be a Gauge, Sum, Histogram, or ExponentialHistogram.
+func AssertEmpty(t TestingT, agg metricdata.Aggregation) bool {
+	t.Helper()
+
+	n, r := dataPointsLen(agg)
+	if len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	if n != 0 {
+		t.Error([]string{fmt.Sprintf("%T contains %d data points, expected none", agg, n)})
+		return false
+	}
+	return true
+}
+
+// AssertNotEmpty asserts that agg contains at least one data point. The agg
+// needs to be a Gauge, Sum, Histogram, or ExponentialHistogram.
+func AssertNotEmpty(t TestingT, agg metricdata.Aggregation) bool {
+	t.Helper()
+
+	n, r := dataPointsLen(agg)
+	if len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	if n == 0 {
+		t.Error([]string{fmt.Sprintf("%T contains no data points", agg)})
+		return false
+	}
+	return true
+}
+
 // AssertHasAttributes asserts that all Datapoints or HistogramDataPoints have all passed attrs.
 func AssertHasAttributes[T Datatypes](t TestingT, actual T, attrs ...attribute.KeyValue) bool {
 	t.Helper()
 
-	var reasons []string
+	if reasons := HasAttributes(actual, attrs...); len(reasons) > 0 {
+		t.Error(reasons)
+		return false
+	}
 
-	switch e := interface{}(actual).(type) {
+	return true
+}
+
+// HasAttributes returns the reasons any Datapoints or HistogramDataPoints of
+// actual do not have all passed attrs. If all attrs are present with matching
+// values, the returned reasons will be empty. The check is the same as
+// AssertHasAttributes.
+//
+// This can be used to build assertions or validators for other testing
+// frameworks.
+func HasAttributes[T Datatypes](actual T, attrs ...attribute.KeyValue) []string {
+	reasons, ok := hasAttributes(interface{}(actual), attrs...)
+	if !ok {
+		// We control all types passed to this, panic to signal developers
+		// early they changed things in an incompatible way.
+		panic(fmt.Sprintf("unknown types: %T", actual))
+	}
+	return reasons
+}
+
+// failure returns reasons, the reasons expected and actual are not equal,
+// as they are reported by a failed assertion.
+func (cfg config) failure(expected, actual interface{}, reasons []string) []string {
+	if cfg.diffFormat != diffReasons {
+		reasons = cfg.pathReasons(expected, actual, reasons)
+	}
+	return cfg.render(cfg.withDiagnostics(expected, actual, reasons))
+}
+
+// hasAttributes returns reasons actual does not have all attrs, and true.
+// The actual value needs to hold a Datatypes type. If it does not hold a
+// known type, false is returned.
+func hasAttributes(actual interface{}, attrs ...attribute.KeyValue) (reasons []string, ok bool) {
+	switch e := actual.(type) {
 	case metricdata.Exemplar[int64]:
 		reasons = hasAttributesExemplars(e, attrs...)
 	case metricdata.Exemplar[float64]:
@@ -231,6 +1140,14 @@ func AssertHasAttributes[T Datatypes](t TestingT, actual T, attrs ...attribute.K
 		reasons = hasAttributesHistogramDataPoints(e, attrs...)
 	case metricdata.Extrema[int64], metricdata.Extrema[float64]:
 		// Nothing to check.
+	case metricdata.Summary[int64]:
+		reasons = hasAttributesSummary(e, attrs...)
+	case metricdata.Summary[float64]:
+		reasons = hasAttributesSummary(e, attrs...)
+	case metricdata.SummaryDataPoint[int64]:
+		reasons = hasAttributesSummaryDataPoints(e, attrs...)
+	case metricdata.SummaryDataPoint[float64]:
+		reasons = hasAttributesSummaryDataPoints(e, attrs...)
 	case metricdata.Histogram[int64]:
 		reasons = hasAttributesHistogram(e, attrs...)
 	case metricdata.Histogram[float64]:
@@ -252,15 +1169,19 @@ func AssertHasAttributes[T Datatypes](t TestingT, actual T, attrs ...attribute.K
 	case metricdata.ExponentialBucket:
 		// Nothing to check.
 	default:
-		// We control all types passed to this, panic to signal developers
-		// early they changed things in an incompatible way.
-		panic(fmt.Sprintf("unknown types: %T", actual))
+		return nil, false
 	}
+	return reasons, true
+}
 
-	if len(reasons) > 0 {
-		t.Error(reasons)
-		return false
-	}
+// AssertHasAttributesSet asserts that all Datapoints or HistogramDataPoints
+// have all attributes contained in set.
+func AssertHasAttributesSet[T Datatypes](t TestingT, actual T, set attribute.Set) bool {
+	t.Helper()
 
-	return true
+	attrs := make([]attribute.KeyValue, 0, set.Len())
+	for iter := set.Iter(); iter.Next(); {
+		attrs = append(attrs, iter.Attribute())
+	}
+	return AssertHasAttributes(t, actual, attrs...)
 }
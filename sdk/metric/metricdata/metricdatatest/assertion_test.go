@@ -15,15 +15,20 @@
 package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 
 import (
+	"fmt"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -623,6 +628,29 @@ func TestTestingTImplementation(t *testing.T) {
 	assert.Implements(t, (*TestingT)(nil), t)
 }
 
+// recordingT is a TestingT that is not a *testing.T.
+type recordingT struct {
+	errors [][]any
+}
+
+func (*recordingT) Helper() {}
+
+func (r *recordingT) Error(args ...any) {
+	r.errors = append(r.errors, args)
+}
+
+func TestCustomTestingT(t *testing.T) {
+	rt := new(recordingT)
+	assert.True(t, AssertEqual(rt, sumInt64A, sumInt64A))
+	assert.True(t, AssertHasAttributes(rt, sumInt64A, attribute.Bool("A", true)))
+	assert.Len(t, rt.errors, 0)
+
+	assert.False(t, AssertEqual(rt, sumInt64A, sumInt64B))
+	assert.False(t, AssertAggregationsEqual(rt, sumInt64A, gaugeInt64A))
+	assert.False(t, AssertHasAttributes(rt, sumInt64A, attribute.Bool("B", true)))
+	assert.Len(t, rt.errors, 3)
+}
+
 func TestAssertEqual(t *testing.T) {
 	t.Run("ResourceMetrics", testDatatype(resourceMetricsA, resourceMetricsB, equalResourceMetrics))
 	t.Run("ScopeMetrics", testDatatype(scopeMetricsA, scopeMetricsB, equalScopeMetrics))
@@ -648,6 +676,48 @@ func TestAssertEqual(t *testing.T) {
 	t.Run("ExponentialBuckets", testDatatype(exponentialBucket2, exponentialBucket3, equalExponentialBuckets))
 }
 
+func TestAssertEqualSummary(t *testing.T) {
+	dpA := metricdata.SummaryDataPoint[int64]{
+		Attributes: attrA,
+		StartTime:  startA,
+		Time:       endA,
+		Count:      2,
+		Min:        minInt64A,
+		Max:        maxInt64B,
+		Sum:        98,
+	}
+	dpB := metricdata.SummaryDataPoint[int64]{
+		Attributes: attrB,
+		StartTime:  startB,
+		Time:       endB,
+		Count:      3,
+		Min:        minInt64B,
+		Max:        maxInt64B,
+		Sum:        150,
+	}
+	summaryA := metricdata.Summary[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.SummaryDataPoint[int64]{dpA},
+	}
+	summaryB := metricdata.Summary[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  []metricdata.SummaryDataPoint[int64]{dpB},
+	}
+
+	t.Run("Summary", testDatatype(summaryA, summaryB, equalSummaries[int64]))
+	t.Run("SummaryDataPoint", testDatatype(dpA, dpB, equalSummaryDataPoints[int64]))
+	t.Run("Aggregation", func(t *testing.T) {
+		AssertAggregationsEqual(t, summaryA, summaryA)
+		r := equalAggregations(summaryA, summaryB, newConfig(nil))
+		assert.Greater(t, len(r), 0, "Summaries should not be equal")
+	})
+	t.Run("HasAttributes", func(t *testing.T) {
+		AssertHasAttributes(t, summaryA, attribute.Bool("A", true))
+		r := HasAttributes(summaryA, attribute.Bool("A", false))
+		assert.Greater(t, len(r), 0, "attribute should not match")
+	})
+}
+
 func TestAssertEqualIgnoreTime(t *testing.T) {
 	t.Run("ResourceMetrics", testDatatypeIgnoreTime(resourceMetricsA, resourceMetricsC, equalResourceMetrics))
 	t.Run("ScopeMetrics", testDatatypeIgnoreTime(scopeMetricsA, scopeMetricsC, equalScopeMetrics))
@@ -925,3 +995,1477 @@ func TestAssertAttributesFail(t *testing.T) {
 	}
 	assert.False(t, AssertHasAttributes(fakeT, sum, attribute.Bool("A", true)))
 }
+
+func TestAssertContains(t *testing.T) {
+	sumInt64 := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B},
+	}
+	metrics := metricdata.Metrics{
+		Name:        "A",
+		Description: "A desc",
+		Unit:        "1",
+		Data:        sumInt64,
+	}
+	scopeMetrics := metricdata.ScopeMetrics{
+		Scope:   scopeMetricsA.Scope,
+		Metrics: []metricdata.Metrics{metrics, metricsB},
+	}
+	resourceMetrics := metricdata.ResourceMetrics{
+		Resource:     resourceMetricsA.Resource,
+		ScopeMetrics: []metricdata.ScopeMetrics{scopeMetrics, scopeMetricsB},
+	}
+
+	AssertContains(t, sumInt64A, sumInt64)
+	AssertContains(t, metricsA, metrics)
+	AssertContains(t, scopeMetricsA, scopeMetrics)
+	AssertContains(t, resourceMetricsA, resourceMetrics)
+	AssertContains(t, resourceMetrics, resourceMetrics)
+	AssertContains(t, resourceMetricsC, resourceMetricsA, IgnoreTimestamp())
+
+	cfg := config{subset: true}
+	r := equalSums(sumInt64A, sumInt64, cfg)
+	assert.Len(t, r, 0, "sum should contain data point: %v", r)
+	r = equalSums(sumInt64, sumInt64A, cfg)
+	assert.Greater(t, len(r), 0, "subset data point not found")
+	r = equalResourceMetrics(resourceMetrics, resourceMetricsA, cfg)
+	assert.Greater(t, len(r), 0, "subset ScopeMetrics not found")
+	r = equalResourceMetrics(resourceMetricsB, resourceMetrics, cfg)
+	assert.Greater(t, len(r), 0, "resources should not be equal")
+
+	fakeT := &testing.T{}
+	assert.False(t, AssertContains(fakeT, sumInt64, sumInt64A))
+	assert.False(t, AssertContains(fakeT, scopeMetrics, scopeMetricsA))
+}
+
+func TestAssertMonotonicIncreasing(t *testing.T) {
+	prev := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: attrA, Value: 1},
+			{Attributes: attrB, Value: 5},
+		},
+	}
+	curr := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: attrB, Value: 5},
+			{Attributes: attrA, Value: 3},
+			{Attributes: *attribute.EmptySet(), Value: 0},
+		},
+	}
+	AssertMonotonicIncreasing(t, prev, curr)
+	AssertMonotonicIncreasing(t, curr, curr)
+
+	r := monotonicIncreasing(curr, prev)
+	assert.Len(t, r, 1, "only attrA decreased: %v", r)
+
+	nonMonotonic := curr
+	nonMonotonic.IsMonotonic = false
+	r = monotonicIncreasing(prev, nonMonotonic)
+	assert.Len(t, r, 1, "should only report non-monotonic: %v", r)
+
+	delta := curr
+	delta.Temporality = metricdata.DeltaTemporality
+	r = monotonicIncreasing(delta, curr)
+	assert.Len(t, r, 1, "should only report temporality: %v", r)
+
+	fltPrev := metricdata.Sum[float64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[float64]{{Attributes: attrA, Value: 1.5}},
+	}
+	fltCurr := fltPrev
+	fltCurr.DataPoints = []metricdata.DataPoint[float64]{{Attributes: attrA, Value: 1.25}}
+	assert.False(t, AssertMonotonicIncreasing(&testing.T{}, fltPrev, fltCurr))
+}
+
+func TestWithValueComparer(t *testing.T) {
+	// Quantize to a tenth.
+	quantized := WithValueComparer(func(a, b float64) bool {
+		return math.Round(a*10) == math.Round(b*10)
+	})
+
+	dpA := metricdata.DataPoint[float64]{Attributes: attrA, Value: 1.01}
+	dpB := metricdata.DataPoint[float64]{Attributes: attrA, Value: 1.04}
+	dpC := metricdata.DataPoint[float64]{Attributes: attrA, Value: 1.06}
+	AssertEqual(t, dpA, dpB, quantized)
+	assert.Greater(t, len(equalDataPoints(dpA, dpB, config{})), 0, "default comparison is exact")
+	assert.Greater(t, len(equalDataPoints(dpA, dpC, newConfig([]Option{quantized}))), 0)
+
+	hdpA := metricdata.HistogramDataPoint[float64]{
+		Attributes: attrA,
+		Min:        metricdata.NewExtrema(0.51),
+		Max:        metricdata.NewExtrema(9.99),
+		Sum:        10.5,
+	}
+	hdpB := hdpA
+	hdpB.Min = metricdata.NewExtrema(0.52)
+	hdpB.Max = metricdata.NewExtrema(9.98)
+	hdpB.Sum = 10.51
+	AssertEqual(t, hdpA, hdpB, quantized)
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, config{}), 3, "Min, Max, and Sum should differ")
+
+	ehdpA := metricdata.ExponentialHistogramDataPoint[float64]{
+		Attributes: attrA,
+		Min:        hdpA.Min,
+		Max:        hdpA.Max,
+		Sum:        hdpA.Sum,
+	}
+	ehdpB := ehdpA
+	ehdpB.Min, ehdpB.Max, ehdpB.Sum = hdpB.Min, hdpB.Max, hdpB.Sum
+	AssertEqual(t, ehdpA, ehdpB, quantized)
+
+	exA := metricdata.Exemplar[int64]{Value: 11}
+	exB := metricdata.Exemplar[int64]{Value: 13}
+	AssertEqual(t, exA, exB, WithValueComparer(func(a, b float64) bool {
+		return math.Round(a/10) == math.Round(b/10)
+	}))
+	assert.Greater(t, len(equalExemplars(exA, exB, config{})), 0, "default comparison is exact")
+}
+
+func TestEqualReasonsIdentifyOrigin(t *testing.T) {
+	r := equalMetrics(metricsA, metricsB, config{})
+	if assert.Greater(t, len(r), 0) {
+		assert.Equal(t, "Metric A:", r[0])
+	}
+
+	sm := scopeMetricsA
+	sm.Metrics = nil
+	r = equalScopeMetrics(scopeMetricsA, sm, config{})
+	if assert.Greater(t, len(r), 0) {
+		assert.Equal(t, "Scope A:", r[0])
+	}
+
+	assert.Len(t, equalMetrics(metricsA, metricsA, config{}), 0)
+	assert.Len(t, equalScopeMetrics(scopeMetricsA, scopeMetricsA, config{}), 0)
+}
+
+func TestAssertEqualIgnoreExemplarFilteredAttributes(t *testing.T) {
+	exInt64 := exemplarInt64A
+	exInt64.FilteredAttributes = fltrAttrB
+	exFloat64 := exemplarFloat64A
+	exFloat64.FilteredAttributes = fltrAttrB
+
+	opt := IgnoreExemplarFilteredAttributes()
+	AssertEqual(t, exemplarInt64A, exInt64, opt)
+	AssertEqual(t, exemplarFloat64A, exFloat64, opt)
+
+	dpInt64 := dataPointInt64A
+	dpInt64.Exemplars = []metricdata.Exemplar[int64]{exInt64}
+	AssertEqual(t, dataPointInt64A, dpInt64, opt)
+
+	r := equalExemplars(exemplarInt64A, exInt64, config{})
+	assert.Len(t, r, 1, "FilteredAttributes should differ")
+
+	// Other fields are still compared.
+	r = equalExemplars(exemplarInt64A, exemplarInt64B, newConfig([]Option{opt}))
+	assert.Greater(t, len(r), 0, "exemplars should not be equal: %v == %v", exemplarInt64A, exemplarInt64B)
+}
+
+func TestEqualExemplarsFilteredAttributesOrder(t *testing.T) {
+	a := metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{
+			attribute.String("a", "1"),
+			attribute.Float64("b", 2),
+		},
+	}
+	b := metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{
+			attribute.Float64("b", 2),
+			attribute.String("a", "1"),
+		},
+	}
+	AssertEqual(t, a, b)
+
+	b.FilteredAttributes = b.FilteredAttributes[:1]
+	r := equalExemplars(a, b, config{})
+	assert.Len(t, r, 1, "FilteredAttributes should differ")
+}
+
+func TestAssertEqualIgnoreTemporality(t *testing.T) {
+	sum := sumInt64A
+	sum.Temporality = metricdata.DeltaTemporality
+	hist := histogramFloat64A
+	hist.Temporality = metricdata.DeltaTemporality
+	expHist := exponentialHistogramInt64A
+	expHist.Temporality = metricdata.DeltaTemporality
+
+	opt := IgnoreTemporality()
+	AssertEqual(t, sumInt64A, sum, opt)
+	AssertEqual(t, histogramFloat64A, hist, opt)
+	AssertEqual(t, exponentialHistogramInt64A, expHist, opt)
+
+	assert.Len(t, equalSums(sumInt64A, sum, config{}), 1, "Temporality should differ")
+	assert.Len(t, equalHistograms(histogramFloat64A, hist, config{}), 1, "Temporality should differ")
+	assert.Len(t, equalExponentialHistograms(exponentialHistogramInt64A, expHist, config{}), 1, "Temporality should differ")
+
+	// Monotonicity is still compared.
+	sum.IsMonotonic = !sum.IsMonotonic
+	assert.Len(t, equalSums(sumInt64A, sum, newConfig([]Option{opt})), 1, "IsMonotonic should differ")
+}
+
+func TestAssertEqualIgnoreMonotonicity(t *testing.T) {
+	sum := sumFloat64A
+	sum.IsMonotonic = !sum.IsMonotonic
+
+	opt := IgnoreMonotonicity()
+	AssertEqual(t, sumFloat64A, sum, opt)
+	assert.Len(t, equalSums(sumFloat64A, sum, config{}), 1, "IsMonotonic should differ")
+
+	// Temporality is still compared.
+	sum.Temporality = metricdata.DeltaTemporality
+	assert.Len(t, equalSums(sumFloat64A, sum, newConfig([]Option{opt})), 1, "Temporality should differ")
+	AssertEqual(t, sumFloat64A, sum, opt, IgnoreTemporality())
+}
+
+func TestAssertEqualOrdered(t *testing.T) {
+	sumAB := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B},
+	}
+	sumBA := sumAB
+	sumBA.DataPoints = []metricdata.DataPoint[int64]{dataPointInt64B, dataPointInt64A}
+
+	AssertEqual(t, sumAB, sumBA)
+	AssertEqualOrdered(t, sumAB, sumAB)
+	assert.False(t, AssertEqualOrdered(&testing.T{}, sumAB, sumBA))
+
+	cfg := config{orderedDataPoints: true}
+	assert.Greater(t, len(equalSums(sumAB, sumBA, cfg)), 0, "order should be compared")
+
+	short := sumAB
+	short.DataPoints = sumAB.DataPoints[:1]
+	assert.Greater(t, len(equalSums(sumAB, short, cfg)), 0, "missing data point")
+	assert.Greater(t, len(equalSums(short, sumAB, cfg)), 0, "additional data point")
+
+	gauge := metricdata.Gauge[float64]{
+		DataPoints: []metricdata.DataPoint[float64]{dataPointFloat64A, dataPointFloat64B},
+	}
+	AssertEqualOrdered(t, gauge, gauge)
+	AssertEqualOrdered(t, histogramInt64A, histogramInt64A)
+	AssertEqualOrdered(t, exponentialHistogramFloat64A, exponentialHistogramFloat64A)
+	AssertEqualOrdered(t, resourceMetricsA, resourceMetricsC, IgnoreTimestamp())
+}
+
+func TestEqualExponentialBucketsPerBucket(t *testing.T) {
+	a := metricdata.ExponentialBucket{Offset: 5, Counts: []uint64{1, 2, 3, 4}}
+	b := metricdata.ExponentialBucket{Offset: 5, Counts: []uint64{1, 0, 3, 5}}
+
+	r := equalExponentialBuckets(a, b, config{})
+	if assert.Len(t, r, 2, "each differing bucket should be reported") {
+		assert.Equal(t, notEqualStr("Counts[1] (bucket index 6)", 2, 0), r[0])
+		assert.Equal(t, notEqualStr("Counts[3] (bucket index 8)", 4, 5), r[1])
+	}
+
+	b.Offset = 4
+	r = equalExponentialBuckets(a, b, config{})
+	if assert.Len(t, r, 2, "offset and counts should be reported") {
+		assert.Equal(t, notEqualStr("Offset", 5, 4), r[0])
+		assert.Contains(t, r[1], "(offset 5)")
+		assert.Contains(t, r[1], "(offset 4)")
+	}
+
+	b = metricdata.ExponentialBucket{Offset: 5, Counts: []uint64{1, 2, 3}}
+	r = equalExponentialBuckets(a, b, config{})
+	assert.Len(t, r, 1, "only counts should be reported")
+
+	assert.Len(t, equalExponentialBuckets(a, a, config{}), 0)
+}
+
+func TestAssertEqualMatchAttributeKeys(t *testing.T) {
+	method := attribute.String("http.method", "GET")
+	dpA := metricdata.DataPoint[int64]{
+		Attributes: attribute.NewSet(method, attribute.Int("pid", 1)),
+		Value:      1,
+	}
+	dpB := metricdata.DataPoint[int64]{
+		Attributes: attribute.NewSet(method, attribute.Int("pid", 2), attribute.String("host", "b")),
+		Value:      1,
+	}
+	opt := MatchAttributeKeys("http.method")
+	AssertEqual(t, dpA, dpB, opt)
+	assert.Len(t, equalDataPoints(dpA, dpB, config{}), 1, "Attributes should differ")
+
+	dpC := dpB
+	dpC.Attributes = attribute.NewSet(attribute.String("http.method", "POST"))
+	assert.Len(t, equalDataPoints(dpA, dpC, newConfig([]Option{opt})), 1, "matched attribute should differ")
+
+	// Keys are combined.
+	cfg := newConfig([]Option{opt, MatchAttributeKeys("pid")})
+	assert.Len(t, equalDataPoints(dpA, dpB, cfg), 1, "pid should be compared")
+
+	hdpA := metricdata.HistogramDataPoint[float64]{Attributes: dpA.Attributes}
+	hdpB := metricdata.HistogramDataPoint[float64]{Attributes: dpB.Attributes}
+	AssertEqual(t, hdpA, hdpB, opt)
+
+	ehdpA := metricdata.ExponentialHistogramDataPoint[float64]{Attributes: dpA.Attributes}
+	ehdpB := metricdata.ExponentialHistogramDataPoint[float64]{Attributes: dpB.Attributes}
+	AssertEqual(t, ehdpA, ehdpB, opt)
+
+	sumA := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{dpA}}
+	sumB := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{dpB}}
+	AssertEqual(t, sumA, sumB, opt)
+}
+
+func TestEqual(t *testing.T) {
+	eq, r := Equal(resourceMetricsA, resourceMetricsA)
+	assert.True(t, eq)
+	assert.Len(t, r, 0)
+
+	eq, r = Equal(resourceMetricsA, resourceMetricsC)
+	assert.False(t, eq)
+	assert.Equal(t, equalResourceMetrics(resourceMetricsA, resourceMetricsC, config{}), r)
+
+	eq, r = Equal(resourceMetricsA, resourceMetricsC, IgnoreTimestamp())
+	assert.True(t, eq)
+	assert.Len(t, r, 0)
+}
+
+func TestEqualAggregation(t *testing.T) {
+	eq, r := EqualAggregation(sumInt64A, sumInt64A)
+	assert.True(t, eq)
+	assert.Len(t, r, 0)
+
+	eq, r = EqualAggregation(sumInt64A, gaugeInt64A)
+	assert.False(t, eq)
+	assert.Len(t, r, 1)
+
+	eq, _ = EqualAggregation(sumInt64A, sumInt64D, IgnoreValue())
+	assert.True(t, eq)
+}
+
+func TestEqualExemplar(t *testing.T) {
+	eq, r := EqualExemplar(exemplarFloat64A, exemplarFloat64A)
+	assert.True(t, eq)
+	assert.Len(t, r, 0)
+
+	eq, r = EqualExemplar(exemplarInt64A, exemplarInt64B)
+	assert.False(t, eq)
+	assert.Greater(t, len(r), 0)
+
+	eq, _ = EqualExemplar(exemplarInt64A, exemplarInt64C, IgnoreTimestamp())
+	assert.True(t, eq)
+}
+
+func TestEqualValuesTolerance(t *testing.T) {
+	abs := config{tolerance: 0.1}
+	assert.True(t, equalValues(1.0, 1.05, abs))
+	assert.True(t, equalValues(1.05, 1.0, abs))
+	assert.False(t, equalValues(1.0, 1.2, abs))
+	assert.True(t, equalValues[int64](10, 10, abs))
+	assert.False(t, equalValues[int64](10, 11, abs))
+	assert.True(t, equalValues[int64](10, 11, config{tolerance: 1}))
+
+	rel := config{relativeTolerance: 0.01}
+	assert.True(t, equalValues(1e9, 1e9+1e6, rel))
+	assert.False(t, equalValues(1e9, 1.1e9, rel))
+	assert.True(t, equalValues(1e-9, 1.001e-9, rel))
+	assert.True(t, equalValues(0.0, 0.0, rel), "zero equals zero")
+	assert.False(t, equalValues(0.0, 1e-12, rel), "zero only equals zero")
+
+	both := config{tolerance: 1e-6, relativeTolerance: 0.01}
+	assert.True(t, equalValues(0.0, 1e-12, both), "absolute tolerance passes")
+	assert.True(t, equalValues(1e9, 1e9+1e6, both), "relative tolerance passes")
+	assert.False(t, equalValues(1.0, 1.1, both))
+
+	comparer := both
+	comparer.valueComparer = func(a, b float64) bool { return false }
+	assert.False(t, equalValues(1.0, 1.0, comparer), "comparer takes precedence")
+}
+
+func TestAssertEqualWithTolerance(t *testing.T) {
+	dpA := metricdata.DataPoint[float64]{Attributes: attrA, Value: 0.3}
+	dpB := metricdata.DataPoint[float64]{Attributes: attrA, Value: 0.1 + 0.2}
+	AssertEqual(t, dpA, dpB, WithTolerance(1e-9))
+	AssertEqual(t, dpA, dpB, WithRelativeTolerance(1e-9))
+
+	hdpA := metricdata.HistogramDataPoint[float64]{
+		Attributes: attrA,
+		Min:        metricdata.NewExtrema(1e-9),
+		Max:        metricdata.NewExtrema(2.0),
+		Sum:        1e3,
+	}
+	hdpB := hdpA
+	hdpB.Min = metricdata.NewExtrema(1.0001e-9)
+	hdpB.Max = metricdata.NewExtrema(2.0002)
+	hdpB.Sum = 1.0001e3
+	AssertEqual(t, hdpA, hdpB, WithRelativeTolerance(1e-3))
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, newConfig([]Option{WithTolerance(1e-3)})), 1, "Sum should differ")
+
+	exA := metricdata.Exemplar[float64]{Value: 1.0}
+	exB := metricdata.Exemplar[float64]{Value: 1.01}
+	AssertEqual(t, exA, exB, WithTolerance(0.1))
+	assert.Len(t, equalExemplars(exA, exB, config{}), 1, "Value should differ")
+}
+
+func TestAssertDataPointValue(t *testing.T) {
+	sum := metricdata.Sum[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B},
+	}
+	AssertDataPointValue(t, sum, attrA, int64(-1))
+	AssertDataPointValue(t, sum, attrB, int64(2))
+	AssertDataPointValue(t, gaugeFloat64A, attrA, -1.0)
+	AssertDataPointValue(t, gaugeFloat64A, attrA, -1.05, WithTolerance(0.1))
+
+	assert.Len(t, dataPointValue(sum, attrA, int64(3), config{}), 1, "value should differ")
+	assert.Len(t, dataPointValue(gaugeFloat64A, attrA, -1.05, config{}), 1, "value should differ")
+
+	r := dataPointValue(sum, *attribute.EmptySet(), int64(-1), config{})
+	assert.Equal(t, []string{"no data point with attributes {}"}, r)
+
+	dup := sum
+	dup.DataPoints = append(dup.DataPoints, dataPointInt64C)
+	r = dataPointValue(dup, attrA, int64(-1), config{})
+	assert.Equal(t, []string{"2 data points with attributes {A=true}"}, r)
+
+	r = dataPointValue(sum, attrA, -1.0, config{})
+	assert.Len(t, r, 1, "number types differ")
+	r = dataPointValue(histogramInt64A, attrA, int64(-1), config{})
+	assert.Len(t, r, 1, "histograms are not supported")
+
+	assert.False(t, AssertDataPointValue(&testing.T{}, sum, attrA, int64(0)))
+}
+
+func TestAssertEqualWithBucketCountTolerance(t *testing.T) {
+	opt := WithBucketCountTolerance(1)
+
+	hdpA := metricdata.HistogramDataPoint[int64]{
+		Attributes:   attrA,
+		Bounds:       []float64{0, 10},
+		BucketCounts: []uint64{1, 5, 3},
+	}
+	hdpB := hdpA
+	hdpB.BucketCounts = []uint64{2, 4, 3}
+	AssertEqual(t, hdpA, hdpB, opt)
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, config{}), 1, "BucketCounts should differ")
+
+	hdpB.BucketCounts = []uint64{3, 4, 3}
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, newConfig([]Option{opt})), 1, "outside tolerance")
+	hdpB.BucketCounts = []uint64{1, 5}
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, newConfig([]Option{opt})), 1, "lengths differ")
+
+	bA := metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{4, 2}}
+	bB := metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{3, 3}}
+	AssertEqual(t, bA, bB, opt)
+	assert.Len(t, equalExponentialBuckets(bA, bB, config{}), 2, "Counts should differ")
+
+	bB.Offset = 2
+	assert.Len(t, equalExponentialBuckets(bA, bB, newConfig([]Option{opt})), 1, "only Offset should differ")
+}
+
+func TestAssertHasAttributesSet(t *testing.T) {
+	AssertHasAttributesSet(t, dataPointInt64A, attrA)
+	AssertHasAttributesSet(t, sumInt64A, attrA)
+	AssertHasAttributesSet(t, histogramFloat64A, attrA)
+	AssertHasAttributesSet(t, resourceMetricsA, attrA)
+	AssertHasAttributesSet(t, exponentialHistogramInt64A, attrA)
+	AssertHasAttributesSet(t, sumInt64A, *attribute.EmptySet())
+
+	fakeT := &testing.T{}
+	assert.False(t, AssertHasAttributesSet(fakeT, dataPointInt64A, attrB))
+	assert.False(t, AssertHasAttributesSet(fakeT, metricsA, attrB))
+	assert.False(t, AssertHasAttributesSet(fakeT, exemplarInt64A, attribute.NewSet(attribute.Bool("A", false))))
+}
+
+func TestDisallowDuplicateSeries(t *testing.T) {
+	opt := DisallowDuplicateSeries()
+
+	dup := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64A},
+	}
+	AssertEqual(t, dup, dup)
+
+	fakeT := &testing.T{}
+	assert.False(t, AssertEqual(fakeT, dup, dup, opt))
+	r := equalSums(dup, dup, newConfig([]Option{opt}))
+	assert.Len(t, r, 2, "expected and actual duplicates should be reported")
+
+	unique := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B},
+	}
+	AssertEqual(t, unique, unique, opt)
+
+	gauge := metricdata.Gauge[float64]{
+		DataPoints: []metricdata.DataPoint[float64]{dataPointFloat64A, dataPointFloat64A, dataPointFloat64A},
+	}
+	r = equalGauges(gauge, metricdata.Gauge[float64]{}, newConfig([]Option{opt}))
+	assert.Contains(t, r, "expected DataPoints contain duplicate series: {A=true}", "three data points are one duplicate series")
+
+	hist := metricdata.Histogram[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.HistogramDataPoint[int64]{histogramDataPointInt64A, histogramDataPointInt64A},
+	}
+	assert.False(t, AssertEqual(fakeT, hist, hist, opt))
+
+	expoHist := metricdata.ExponentialHistogram[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints: []metricdata.ExponentialHistogramDataPoint[int64]{
+			exponentialHistogramDataPointInt64A,
+			exponentialHistogramDataPointInt64A,
+		},
+	}
+	assert.False(t, AssertEqual(fakeT, expoHist, expoHist, opt))
+}
+
+func TestWithNumericTypeCoercion(t *testing.T) {
+	opt := WithNumericTypeCoercion()
+
+	gaugeInt := metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{dataPointInt64A}}
+	gaugeFloat := metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{dataPointFloat64A}}
+	AssertAggregationsEqual(t, gaugeInt, gaugeFloat, opt)
+	AssertAggregationsEqual(t, gaugeFloat, gaugeInt, opt)
+	assert.Len(t, equalAggregations(gaugeInt, gaugeFloat, config{}), 1, "types should differ without coercion")
+
+	sumInt := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A},
+	}
+	sumFloat := metricdata.Sum[float64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[float64]{dataPointFloat64A},
+	}
+	AssertAggregationsEqual(t, sumInt, sumFloat, opt)
+
+	sumFloat.Temporality = metricdata.DeltaTemporality
+	assert.NotEmpty(t, equalAggregations(sumInt, sumFloat, newConfig([]Option{opt})), "Temporality should still be compared")
+
+	r := equalAggregations(sumInt, gaugeFloat, newConfig([]Option{opt}))
+	assert.Len(t, r, 1, "Sum and Gauge should not be coerced")
+
+	hist := metricdata.Histogram[float64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.HistogramDataPoint[float64]{histogramDataPointFloat64A},
+	}
+	assert.Len(t, equalAggregations(histogramInt64A, hist, newConfig([]Option{opt})), 1, "Histograms should not be coerced")
+}
+
+func TestAssertEmpty(t *testing.T) {
+	fakeT := &testing.T{}
+
+	AssertEmpty(t, metricdata.Gauge[int64]{})
+	AssertEmpty(t, metricdata.Sum[float64]{DataPoints: []metricdata.DataPoint[float64]{}})
+	AssertEmpty(t, metricdata.Histogram[int64]{Temporality: metricdata.DeltaTemporality})
+	AssertEmpty(t, metricdata.ExponentialHistogram[float64]{})
+	assert.False(t, AssertEmpty(fakeT, histogramInt64A))
+	assert.False(t, AssertEmpty(fakeT, nil))
+
+	AssertNotEmpty(t, histogramInt64A)
+	AssertNotEmpty(t, metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{dataPointFloat64A}})
+	assert.False(t, AssertNotEmpty(fakeT, metricdata.Sum[int64]{}))
+	assert.False(t, AssertNotEmpty(fakeT, nil))
+}
+
+func TestIgnoreTrailingZeroBuckets(t *testing.T) {
+	opt := IgnoreTrailingZeroBuckets()
+
+	hdpA := metricdata.HistogramDataPoint[int64]{
+		Attributes:   attrA,
+		Count:        3,
+		Bounds:       []float64{0, 10, 100},
+		BucketCounts: []uint64{1, 2, 0, 0},
+	}
+	hdpB := hdpA
+	hdpB.Bounds = []float64{0, 10}
+	hdpB.BucketCounts = []uint64{1, 2, 0}
+	AssertEqual(t, hdpA, hdpB, opt)
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, config{}), 2, "Bounds and BucketCounts should differ")
+
+	// Non-zero trailing buckets are not removed.
+	hdpB.BucketCounts = []uint64{1, 1, 1}
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, newConfig([]Option{opt})), 2, "non-zero bucket trimmed")
+
+	bounds, counts := trimTrailingZeroBuckets([]float64{0, 10}, []uint64{0, 0, 0})
+	assert.Empty(t, bounds)
+	assert.Equal(t, []uint64{0}, counts, "first bucket should not be trimmed")
+
+	bounds, counts = trimTrailingZeroBuckets([]float64{0, 10}, []uint64{0, 0})
+	assert.Equal(t, []float64{0, 10}, bounds, "malformed buckets should not be trimmed")
+	assert.Equal(t, []uint64{0, 0}, counts, "malformed buckets should not be trimmed")
+}
+
+func TestValueDifferenceReported(t *testing.T) {
+	dpA := metricdata.DataPoint[int64]{Attributes: attrA, Value: 5}
+	dpB := dpA
+	dpB.Value = 5000
+	r := equalDataPoints(dpA, dpB, config{})
+	assert.Equal(t, []string{"Value not equal:\nexpected: 5\nactual: 5000 (diff 4995)"}, r)
+
+	eA := metricdata.Exemplar[float64]{Value: 2.5}
+	eB := metricdata.Exemplar[float64]{Value: 0.5}
+	r = equalExemplars(eA, eB, config{})
+	assert.Equal(t, []string{"Value not equal:\nexpected: 2.5\nactual: 0.5 (diff -2)"}, r)
+}
+
+func TestAssertEqualNamedWrappers(t *testing.T) {
+	fakeT := &testing.T{}
+
+	AssertEqualResourceMetrics(t, resourceMetricsA, resourceMetricsA)
+	assert.False(t, AssertEqualResourceMetrics(fakeT, resourceMetricsA, resourceMetricsB))
+
+	AssertEqualScopeMetrics(t, scopeMetricsA, scopeMetricsA)
+	assert.False(t, AssertEqualScopeMetrics(fakeT, scopeMetricsA, scopeMetricsB))
+
+	AssertEqualMetrics(t, metricsA, metricsA)
+	assert.False(t, AssertEqualMetrics(fakeT, metricsA, metricsB))
+	AssertEqualMetrics(t, metricsA, metricsC, IgnoreTimestamp())
+}
+
+func TestExtremaTolerance(t *testing.T) {
+	opt := WithTolerance(1e-6)
+
+	a := metricdata.NewExtrema(0.1)
+	b := metricdata.NewExtrema(0.10000001)
+	AssertEqual(t, a, b, opt)
+	AssertEqual(t, a, b, WithRelativeTolerance(1e-6))
+	assert.Len(t, equalExtrema(a, b, config{}), 1, "exact comparison without tolerance")
+
+	unset := metricdata.Extrema[float64]{}
+	AssertEqual(t, unset, unset, opt)
+	assert.Len(t, equalExtrema(a, unset, newConfig([]Option{opt})), 1, "unset Extrema should differ from set")
+	assert.Len(t, equalExtrema(metricdata.NewExtrema(0.0), unset, newConfig([]Option{WithTolerance(1)})), 1, "unset Extrema should not be tolerant")
+
+	hdpA := metricdata.HistogramDataPoint[float64]{Attributes: attrA, Min: a, Max: a}
+	hdpB := metricdata.HistogramDataPoint[float64]{Attributes: attrA, Min: b, Max: b}
+	AssertEqual(t, hdpA, hdpB, opt)
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, config{}), 2, "Min and Max should differ")
+
+	ehdpA := metricdata.ExponentialHistogramDataPoint[float64]{Attributes: attrA, Min: a, Max: a}
+	ehdpB := metricdata.ExponentialHistogramDataPoint[float64]{Attributes: attrA, Min: b, Max: b}
+	AssertEqual(t, ehdpA, ehdpB, opt)
+	assert.Len(t, equalExponentialHistogramDataPoints(ehdpA, ehdpB, config{}), 2, "Min and Max should differ")
+}
+
+func TestIgnoreZeroStartTime(t *testing.T) {
+	opt := IgnoreZeroStartTime()
+
+	dpA := metricdata.DataPoint[int64]{Attributes: attrA, StartTime: startA, Time: endA, Value: 1}
+	dpB := dpA
+	dpB.StartTime = time.Time{}
+	AssertEqual(t, dpA, dpB, opt)
+	AssertEqual(t, dpB, dpA, opt)
+	assert.Len(t, equalDataPoints(dpA, dpB, config{}), 1, "StartTime should differ")
+
+	dpB.StartTime = startB
+	assert.Len(t, equalDataPoints(dpA, dpB, newConfig([]Option{opt})), 1, "set StartTimes should be compared")
+
+	dpB.StartTime = time.Time{}
+	dpB.Time = endB
+	assert.Len(t, equalDataPoints(dpA, dpB, newConfig([]Option{opt})), 1, "Time should be compared")
+
+	hdpA := metricdata.HistogramDataPoint[int64]{Attributes: attrA, StartTime: startA}
+	hdpB := metricdata.HistogramDataPoint[int64]{Attributes: attrA}
+	AssertEqual(t, hdpA, hdpB, opt)
+
+	ehdpA := metricdata.ExponentialHistogramDataPoint[int64]{Attributes: attrA, StartTime: startA}
+	ehdpB := metricdata.ExponentialHistogramDataPoint[int64]{Attributes: attrA}
+	AssertEqual(t, ehdpA, ehdpB, opt)
+}
+
+func TestAssertHistogramDistributionEqual(t *testing.T) {
+	hdpA := metricdata.HistogramDataPoint[int64]{
+		Attributes:   attrA,
+		Count:        6,
+		Bounds:       []float64{0, 5, 10, 50},
+		BucketCounts: []uint64{1, 2, 1, 2, 0},
+		Min:          metricdata.NewExtrema[int64](-1),
+		Max:          metricdata.NewExtrema[int64](20),
+		Sum:          40,
+	}
+	hdpB := metricdata.HistogramDataPoint[int64]{
+		Attributes:   attrB,
+		Count:        6,
+		Bounds:       []float64{0, 10, 100},
+		BucketCounts: []uint64{1, 3, 2, 0},
+		Min:          metricdata.NewExtrema[int64](-1),
+		Max:          metricdata.NewExtrema[int64](20),
+		Sum:          40,
+	}
+	AssertHistogramDistributionEqual(t, hdpA, hdpB)
+	AssertHistogramDistributionEqual(t, hdpB, hdpA)
+
+	fakeT := &testing.T{}
+	assert.False(t, AssertEqual(fakeT, hdpA, hdpB), "strict comparison should fail")
+
+	hdpB.BucketCounts = []uint64{1, 2, 3, 0}
+	r := equalHistogramDistributions(hdpA, hdpB, config{})
+	assert.Equal(t, []string{"cumulative count at bound 10 not equal:\nexpected: 4\nactual: 3"}, r)
+	AssertHistogramDistributionEqual(t, hdpA, hdpB, WithBucketCountTolerance(1))
+
+	hdpB.BucketCounts = []uint64{1, 3, 2, 0}
+	hdpB.Count = 7
+	hdpB.Max = metricdata.NewExtrema[int64](21)
+	assert.Len(t, equalHistogramDistributions(hdpA, hdpB, config{}), 2, "Count and Max should differ")
+
+	hdpB.BucketCounts = []uint64{1}
+	assert.False(t, AssertHistogramDistributionEqual(fakeT, hdpA, hdpB))
+}
+
+func TestFloatAttributeTolerance(t *testing.T) {
+	opt := WithTolerance(0.01)
+
+	dpA := metricdata.DataPoint[int64]{
+		Attributes: attribute.NewSet(attribute.Float64("ratio", 0.5), attribute.String("s", "a")),
+		Value:      1,
+	}
+	dpB := dpA
+	dpB.Attributes = attribute.NewSet(attribute.Float64("ratio", 0.505), attribute.String("s", "a"))
+	AssertEqual(t, dpA, dpB, opt)
+	assert.Len(t, equalDataPoints(dpA, dpB, config{}), 1, "exact comparison without tolerance")
+
+	dpB.Attributes = attribute.NewSet(attribute.Float64("ratio", 0.52), attribute.String("s", "a"))
+	assert.Len(t, equalDataPoints(dpA, dpB, newConfig([]Option{opt})), 1, "outside tolerance")
+
+	dpB.Attributes = attribute.NewSet(attribute.Float64("ratio", 0.5), attribute.String("s", "b"))
+	assert.Len(t, equalDataPoints(dpA, dpB, newConfig([]Option{opt})), 1, "non-float values are compared exactly")
+
+	slice := func(v ...float64) attribute.Set {
+		return attribute.NewSet(attribute.Float64Slice("bounds", v))
+	}
+	cfg := newConfig([]Option{opt})
+	assert.True(t, equalSets(slice(1, 2), slice(1.001, 2.009), cfg), "inside tolerance")
+	assert.False(t, equalSets(slice(1, 2), slice(1.001, 2.02), cfg), "outside tolerance")
+	assert.False(t, equalSets(slice(1, 2), slice(1), cfg), "lengths differ")
+
+	exA := metricdata.Exemplar[int64]{FilteredAttributes: []attribute.KeyValue{attribute.Float64("f", 1)}}
+	exB := metricdata.Exemplar[int64]{FilteredAttributes: []attribute.KeyValue{attribute.Float64("f", 1.001)}}
+	AssertEqual(t, exA, exB, opt)
+}
+
+type loggingT struct {
+	recordingT
+	logs [][]any
+}
+
+func (l *loggingT) Log(args ...any) {
+	l.logs = append(l.logs, args)
+}
+
+func TestAssertNotEqual(t *testing.T) {
+	AssertNotEqual(t, sumInt64A, sumInt64B)
+	AssertNotEqual(t, resourceMetricsA, resourceMetricsC)
+
+	rt := new(recordingT)
+	assert.False(t, AssertNotEqual(rt, resourceMetricsA, resourceMetricsC, IgnoreTimestamp()))
+	require.Len(t, rt.errors, 1)
+	assert.Equal(t, []any{[]string{"values are unexpectedly equal:\n" + Dump(resourceMetricsA)}}, rt.errors[0])
+
+	lt := new(loggingT)
+	assert.True(t, AssertNotEqual(lt, sumInt64A, sumInt64B))
+	assert.Empty(t, lt.errors)
+	assert.Empty(t, lt.logs, "passing assertion logged")
+}
+
+func TestCompareDiffDeterministic(t *testing.T) {
+	want := compareDiff(
+		config{},
+		[]metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B},
+		[]metricdata.DataPoint[int64]{dataPointInt64C, dataPointInt64D},
+	)
+	got := compareDiff(
+		config{},
+		[]metricdata.DataPoint[int64]{dataPointInt64B, dataPointInt64A},
+		[]metricdata.DataPoint[int64]{dataPointInt64D, dataPointInt64C},
+	)
+	assert.Equal(t, want, got)
+
+	assert.Equal(t,
+		compareDiff(config{}, []metricdata.Metrics{metricsA, metricsB}, nil),
+		compareDiff(config{}, []metricdata.Metrics{metricsB, metricsA}, nil),
+	)
+}
+
+func TestWithResourceAttributeKeys(t *testing.T) {
+	opt := WithResourceAttributeKeys("service.name")
+
+	rmA := metricdata.ResourceMetrics{
+		Resource: resource.NewWithAttributes("https://schema", attribute.String("service.name", "svc"), attribute.String("host.name", "a")),
+	}
+	rmB := metricdata.ResourceMetrics{
+		Resource: resource.NewWithAttributes("https://schema", attribute.String("service.name", "svc"), attribute.String("host.name", "b")),
+	}
+	AssertEqual(t, rmA, rmB, opt)
+	assert.Len(t, equalResourceMetrics(rmA, rmB, config{}), 1, "Resources should differ")
+
+	rmB.Resource = resource.NewWithAttributes("https://schema", attribute.String("service.name", "other"))
+	r := equalResourceMetrics(rmA, rmB, newConfig([]Option{opt}))
+	assert.Equal(t, []string{"Resource Attributes not equal:\nonly in expected: {}\nonly in actual: {}\ndifferent values: {service.name: expected svc, actual other}"}, r)
+
+	rmB.Resource = resource.NewWithAttributes("https://other", attribute.String("service.name", "svc"))
+	r = equalResourceMetrics(rmA, rmB, newConfig([]Option{opt}))
+	assert.Equal(t, []string{"Resource SchemaURL not equal:\nexpected: https://schema\nactual: https://other"}, r)
+
+	rmB.Resource = resource.NewWithAttributes("https://schema", attribute.String("host.name", "a"))
+	assert.Len(t, equalResourceMetrics(rmA, rmB, newConfig([]Option{opt, WithResourceAttributeKeys("host.name")})), 1, "keys should accumulate")
+}
+
+func TestAssertExemplarCount(t *testing.T) {
+	AssertExemplarCount(t, dataPointInt64A, 1)
+	AssertExemplarCount(t, metricdata.DataPoint[float64]{}, 0)
+	AssertHistogramExemplarCount(t, histogramDataPointFloat64A, 1)
+	AssertExponentialHistogramExemplarCount(t, exponentialHistogramDataPointInt64A, 1)
+
+	rt := new(recordingT)
+	assert.False(t, AssertExemplarCount(rt, dataPointInt64A, 2))
+	assert.False(t, AssertHistogramExemplarCount(rt, histogramDataPointInt64A, 0))
+	assert.False(t, AssertExponentialHistogramExemplarCount(rt, exponentialHistogramDataPointFloat64A, 3))
+	require.Len(t, rt.errors, 3)
+	assert.Equal(t, []any{[]string{"DataPoint {A=true} Exemplar count not equal:\nexpected: 2\nactual: 1"}}, rt.errors[0])
+}
+
+func TestAssertHasExemplar(t *testing.T) {
+	exemplars := []metricdata.Exemplar[int64]{exemplarInt64B, exemplarInt64A}
+	AssertHasExemplar(t, exemplars, exemplarInt64A)
+
+	e := exemplarInt64A
+	e.Time = endB
+	AssertHasExemplar(t, exemplars, e, IgnoreTimestamp())
+
+	rt := new(recordingT)
+	assert.False(t, AssertHasExemplar(rt, exemplars, e))
+	assert.False(t, AssertHasExemplar(rt, nil, exemplarInt64A))
+	require.Len(t, rt.errors, 2)
+	assert.Contains(t, fmt.Sprint(rt.errors[0]...), "Exemplar not found")
+}
+
+func TestAssertExemplarsLinkTrace(t *testing.T) {
+	var (
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	)
+	copy(traceID[:], traceIDA)
+	copy(spanID[:], spanIDA)
+
+	exemplars := []metricdata.Exemplar[float64]{exemplarFloat64B, exemplarFloat64A}
+	AssertExemplarsLinkTrace(t, exemplars, traceID, spanID)
+
+	rt := new(recordingT)
+	assert.False(t, AssertExemplarsLinkTrace(rt, exemplars[:1], traceID, spanID))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, fmt.Sprint(rt.errors[0]...), "no Exemplar with TraceID "+traceID.String())
+}
+
+func TestExemplarSubset(t *testing.T) {
+	opt := ExemplarSubset()
+
+	dpA := metricdata.DataPoint[int64]{
+		Attributes: attrA,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplarInt64A},
+	}
+	dpB := dpA
+	dpB.Exemplars = []metricdata.Exemplar[int64]{exemplarInt64B, exemplarInt64A}
+	AssertEqual(t, dpA, dpB, opt)
+	assert.True(t, EqualFast(dpA, dpB, opt))
+	assert.Len(t, equalDataPoints(dpA, dpB, config{}), 1, "additional Exemplar should be reported")
+
+	// Missing expected Exemplars are still reported.
+	assert.Len(t, equalDataPoints(dpB, dpA, newConfig([]Option{opt})), 1, "missing Exemplar")
+	assert.False(t, EqualFast(dpB, dpA, opt))
+
+	// Data points are still compared exactly.
+	sumA := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{dpA}}
+	sumB := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{dpB, dataPointInt64B}}
+	assert.Len(t, equalSums(sumA, sumB, newConfig([]Option{opt})), 1, "additional data point")
+
+	hdpA := metricdata.HistogramDataPoint[int64]{Attributes: attrA, Exemplars: dpA.Exemplars}
+	hdpB := metricdata.HistogramDataPoint[int64]{Attributes: attrA, Exemplars: dpB.Exemplars}
+	AssertEqual(t, hdpA, hdpB, opt)
+
+	ehdpA := metricdata.ExponentialHistogramDataPoint[int64]{Attributes: attrA, Exemplars: dpA.Exemplars}
+	ehdpB := metricdata.ExponentialHistogramDataPoint[int64]{Attributes: attrA, Exemplars: dpB.Exemplars}
+	AssertEqual(t, ehdpA, ehdpB, opt)
+}
+
+func TestAttributesDiff(t *testing.T) {
+	a := attribute.NewSet(
+		attribute.String("a", "1"),
+		attribute.String("b", "2"),
+		attribute.String("c", "3"),
+	)
+	b := attribute.NewSet(
+		attribute.String("b", "2"),
+		attribute.String("c", "4"),
+		attribute.String("d", "5"),
+	)
+	want := "Attributes not equal:\n" +
+		"only in expected: {a=1}\n" +
+		"only in actual: {d=5}\n" +
+		"different values: {c: expected 3, actual 4}"
+	assert.Equal(t, []string{want}, equalAttributes(a, b, config{}))
+
+	want = "Attributes not equal:\n" +
+		"only in expected: {}\n" +
+		"only in actual: {a=1, b=2, c=3}\n" +
+		"different values: {}"
+	assert.Equal(t, []string{want}, equalAttributes(*attribute.EmptySet(), a, config{}))
+}
+
+func TestHasAttributes(t *testing.T) {
+	assert.Empty(t, HasAttributes(dataPointInt64A, attribute.Bool("A", true)))
+	assert.Empty(t, HasAttributes(resourceMetricsA, attribute.Bool("A", true)))
+	assert.Empty(t, HasAttributes(sumInt64A))
+
+	r := HasAttributes(dataPointInt64A, attribute.Bool("B", true))
+	assert.Equal(t, []string{missingAttrStr("B")}, r)
+
+	r = HasAttributes(sumInt64A, attribute.Bool("A", false))
+	assert.Equal(t, hasAttributesSum(sumInt64A, attribute.Bool("A", false)), r)
+	assert.NotEmpty(t, r)
+}
+
+func TestWithMetricKey(t *testing.T) {
+	byName := WithMetricKey(func(m metricdata.Metrics) string { return m.Name })
+
+	changed := metricsA
+	changed.Data = sumInt64B
+	expected := metricdata.ScopeMetrics{Metrics: []metricdata.Metrics{metricsA, metricsB}}
+	actual := metricdata.ScopeMetrics{Metrics: []metricdata.Metrics{metricsB, changed}}
+
+	r := equalScopeMetrics(expected, actual, newConfig(nil))
+	require.Len(t, r, 2)
+	assert.Contains(t, r[1], "missing expected values:")
+	assert.Contains(t, r[1], "unexpected additional values:")
+
+	cfg := newConfig([]Option{byName})
+	r = equalScopeMetrics(expected, actual, cfg)
+	assert.Equal(t, append([]string{"Scope :"}, equalMetrics(metricsA, changed, cfg)...), r)
+	assert.False(t, eqScopeMetrics(expected, actual, cfg))
+
+	assert.Empty(t, equalScopeMetrics(expected, expected, cfg))
+	assert.True(t, eqScopeMetrics(expected, expected, cfg))
+
+	// Unpaired Metrics are reported as missing or unexpected.
+	renamed := metricsA
+	renamed.Name = "renamed"
+	actual.Metrics = []metricdata.Metrics{metricsB, renamed}
+	r = equalScopeMetrics(expected, actual, cfg)
+	require.Len(t, r, 2)
+	assert.Contains(t, r[1], "missing expected values:")
+	assert.Contains(t, r[1], "unexpected additional values:")
+	assert.False(t, eqScopeMetrics(expected, actual, cfg))
+
+	subset := cfg
+	subset.subset = true
+	actual.Metrics = []metricdata.Metrics{renamed, metricsB, metricsA}
+	assert.Empty(t, equalScopeMetrics(expected, actual, subset))
+	assert.True(t, eqScopeMetrics(expected, actual, subset))
+}
+
+func TestUnknownAttributeValueType(t *testing.T) {
+	// The zero Value has the INVALID type. It is not expected to be used,
+	// but comparing it needs to fail the assertion, not panic.
+	unknown := attribute.KeyValue{Key: "k"}
+	known := attribute.Int("k", 1)
+
+	assert.True(t, equalKeyValue(unknown, unknown, config{}))
+	assert.False(t, equalKeyValue(unknown, known, config{}))
+
+	expected := dataPointInt64A
+	expected.Attributes = attribute.NewSet(unknown)
+	actual := dataPointInt64A
+	actual.Attributes = attribute.NewSet(known)
+	assert.NotPanics(t, func() {
+		assert.False(t, AssertEqual(new(recordingT), expected, actual))
+		assert.NotEmpty(t, HasAttributes(expected, known))
+	})
+
+	eA, eB := exemplarInt64A, exemplarInt64A
+	eA.FilteredAttributes = []attribute.KeyValue{unknown}
+	eB.FilteredAttributes = []attribute.KeyValue{known}
+	assert.NotPanics(t, func() {
+		assert.False(t, AssertEqual(new(recordingT), eA, eB))
+		assert.True(t, AssertEqual(t, eA, eA))
+	})
+}
+
+func TestIgnoreHistogramSumMinMax(t *testing.T) {
+	sum, minMax := newConfig([]Option{IgnoreHistogramSum()}), newConfig([]Option{IgnoreHistogramMinMax()})
+
+	hA, hB := histogramDataPointInt64A, histogramDataPointInt64A
+	hB.Sum++
+	assert.Len(t, equalHistogramDataPoints(hA, hB, config{}), 1)
+	assert.Empty(t, equalHistogramDataPoints(hA, hB, sum))
+	assert.Len(t, equalHistogramDataPoints(hA, hB, minMax), 1)
+	assert.True(t, eqHistogramDataPoints(hA, hB, sum))
+
+	hB = histogramDataPointInt64A
+	hB.Min, hB.Max = metricdata.Extrema[int64]{}, metricdata.NewExtrema[int64](100)
+	assert.Len(t, equalHistogramDataPoints(hA, hB, config{}), 2)
+	assert.Empty(t, equalHistogramDataPoints(hA, hB, minMax))
+	assert.Len(t, equalHistogramDataPoints(hA, hB, sum), 2)
+	assert.True(t, eqHistogramDataPoints(hA, hB, minMax))
+	assert.False(t, eqHistogramDataPoints(hA, hB, sum))
+
+	// Bucket counts are still compared.
+	hB.BucketCounts = append([]uint64(nil), hB.BucketCounts...)
+	hB.BucketCounts[0]++
+	assert.Len(t, equalHistogramDataPoints(hA, hB, minMax), 1)
+	assert.False(t, eqHistogramDataPoints(hA, hB, minMax))
+
+	eA, eB := exponentialHistogramDataPointInt64A, exponentialHistogramDataPointInt64A
+	eB.Sum++
+	eB.Min = metricdata.Extrema[int64]{}
+	assert.Len(t, equalExponentialHistogramDataPoints(eA, eB, config{}), 2)
+	assert.Len(t, equalExponentialHistogramDataPoints(eA, eB, sum), 1)
+	both := newConfig([]Option{IgnoreHistogramSum(), IgnoreHistogramMinMax()})
+	assert.Empty(t, equalExponentialHistogramDataPoints(eA, eB, both))
+	assert.True(t, eqExponentialHistogramDataPoints(eA, eB, both))
+}
+
+func TestExplain(t *testing.T) {
+	assert.Empty(t, Explain(resourceMetricsA, resourceMetricsA))
+	assert.Empty(t, Explain(resourceMetricsA, resourceMetricsC, IgnoreTimestamp()))
+
+	for _, opts := range [][]Option{nil, {WithDiagnostics()}} {
+		rt := new(recordingT)
+		require.False(t, AssertEqual(rt, resourceMetricsA, resourceMetricsC, opts...))
+		require.Len(t, rt.errors, 1)
+
+		// The same text a *testing.T logs for the failure.
+		want := strings.TrimSuffix(fmt.Sprintln(rt.errors[0]...), "\n")
+		assert.Equal(t, want, Explain(resourceMetricsA, resourceMetricsC, opts...))
+	}
+	assert.Contains(t, Explain(sumInt64A, sumInt64B, WithDiagnostics()), "diagnostics:")
+}
+
+func TestTreatZeroAsUnset(t *testing.T) {
+	opt := TreatZeroAsUnset()
+	cfg := newConfig([]Option{opt})
+
+	unset := dataPointInt64A
+	unset.Value = 0
+	assert.NotEmpty(t, equalDataPoints(dataPointInt64A, unset, config{}))
+	assert.Empty(t, equalDataPoints(dataPointInt64A, unset, cfg))
+	assert.Empty(t, equalDataPoints(unset, dataPointInt64A, cfg))
+	assert.True(t, eqDataPoints(dataPointInt64A, unset, cfg))
+
+	other := dataPointInt64A
+	other.Value = 3
+	assert.NotEmpty(t, equalDataPoints(dataPointInt64A, other, cfg), "non-zero values compared")
+	assert.False(t, eqDataPoints(dataPointInt64A, other, cfg))
+
+	expected := sumInt64A
+	expected.DataPoints = []metricdata.DataPoint[int64]{unset}
+	assert.True(t, AssertEqual(t, expected, sumInt64A, opt))
+}
+
+func TestEqualResourcesReportsFieldsSeparately(t *testing.T) {
+	a := resource.NewWithAttributes("https://schema", attribute.String("service.name", "svc"))
+
+	b := resource.NewWithAttributes("https://other", attribute.String("service.name", "svc"))
+	assert.Equal(t, []string{"Resource SchemaURL not equal:\nexpected: https://schema\nactual: https://other"}, equalResources(a, b, config{}))
+	assert.False(t, EqualFast(metricdata.ResourceMetrics{Resource: a}, metricdata.ResourceMetrics{Resource: b}))
+
+	b = resource.NewWithAttributes("https://other", attribute.String("service.name", "svc"), attribute.String("host.name", "b"))
+	r := equalResources(a, b, config{})
+	require.Len(t, r, 2)
+	assert.Equal(t, "Resource SchemaURL not equal:\nexpected: https://schema\nactual: https://other", r[0])
+	assert.Equal(t, "Resource Attributes not equal:\nonly in expected: {}\nonly in actual: {host.name=b}\ndifferent values: {}", r[1])
+
+	assert.Empty(t, equalResources(a, a, config{}))
+	assert.Empty(t, equalResources(nil, resource.Empty(), config{}))
+}
+
+func TestAssertExponentialHistogramValid(t *testing.T) {
+	valid := metricdata.ExponentialHistogramDataPoint[float64]{
+		Attributes:     attrA,
+		Count:          6,
+		ZeroCount:      1,
+		Scale:          2,
+		PositiveBucket: metricdata.ExponentialBucket{Offset: -2, Counts: []uint64{1, 2}},
+		NegativeBucket: metricdata.ExponentialBucket{Offset: 3, Counts: []uint64{2}},
+		Min:            metricdata.NewExtrema(-3.),
+		Max:            metricdata.NewExtrema(4.),
+	}
+	assert.True(t, AssertExponentialHistogramValid(t, valid))
+
+	invalid := valid
+	invalid.Count = 5
+	invalid.Scale = 21
+	invalid.PositiveBucket.Offset = math.MaxInt32
+	invalid.Min, invalid.Max = invalid.Max, invalid.Min
+
+	rT := new(recordingT)
+	assert.False(t, AssertExponentialHistogramValid(rT, invalid))
+	require.Len(t, rT.errors, 1)
+	r := rT.errors[0][0].([]string)
+	require.Len(t, r, 4, "each violation reported")
+	assert.Contains(t, r[0], "Count not equal to ZeroCount and bucket counts:\nCount: 5\ntotal: 6")
+	assert.Contains(t, r[1], "Scale 21 not in valid range [-10, 20]")
+	assert.Contains(t, r[2], "PositiveBucket Offset 2147483647 with 2 Counts overflows int32")
+	assert.Contains(t, r[3], "Min 4 greater than Max -3")
+
+	invalid = valid
+	invalid.Scale = -11
+	invalid.NegativeBucket.Offset = math.MaxInt32
+	invalid.NegativeBucket.Counts = []uint64{1, 1}
+	assert.Len(t, validExponentialHistogram(invalid), 2)
+	invalid.NegativeBucket.Counts = []uint64{2}
+	invalid.Scale = -10
+	assert.Empty(t, validExponentialHistogram(invalid), "last index of MaxInt32 is valid")
+}
+
+func TestOnlyMetrics(t *testing.T) {
+	server := metricdata.Metrics{Name: "http.server.duration", Data: sumInt64A}
+	client := metricdata.Metrics{Name: "http.client.duration", Data: sumInt64A}
+	other := metricdata.Metrics{Name: "runtime.uptime", Data: gaugeFloat64B}
+
+	expected := metricdata.ScopeMetrics{Metrics: []metricdata.Metrics{server}}
+	actual := metricdata.ScopeMetrics{Metrics: []metricdata.Metrics{other, server, client}}
+
+	opt := OnlyMetrics("http.server.*")
+	assert.NotEmpty(t, equalScopeMetrics(expected, actual, config{}))
+	assert.Empty(t, equalScopeMetrics(expected, actual, newConfig([]Option{opt})))
+	assert.True(t, EqualFast(expected, actual, opt))
+
+	changed := server
+	changed.Data = sumInt64B
+	actual.Metrics = []metricdata.Metrics{other, changed, client}
+	assert.NotEmpty(t, equalScopeMetrics(expected, actual, newConfig([]Option{opt})))
+	assert.False(t, EqualFast(expected, actual, opt))
+
+	// Patterns accumulate.
+	actual.Metrics = []metricdata.Metrics{other, server, client}
+	expected.Metrics = []metricdata.Metrics{client, server}
+	opts := []Option{opt, OnlyMetrics("http.client.*")}
+	assert.Empty(t, equalScopeMetrics(expected, actual, newConfig(opts)))
+	assert.Empty(t, equalScopeMetrics(expected, actual, newConfig([]Option{opt})), "expected Metrics not matching ignored")
+
+	assert.Panics(t, func() { OnlyMetrics("[") })
+}
+
+func TestAssertSumValues(t *testing.T) {
+	sum := metricdata.Sum[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: attrA, StartTime: startA, Time: endA, Value: 1},
+			{Attributes: attrB, StartTime: startB, Time: endB, Value: 2},
+		},
+	}
+	assert.True(t, AssertSumValues(t, sum, map[attribute.Set]int64{attrA: 1, attrB: 2}))
+	assert.True(t, AssertSumValues(t, metricdata.Sum[int64]{}, map[attribute.Set]int64{}))
+
+	r := dataPointValues(sum.DataPoints, map[attribute.Set]int64{attrA: 3}, config{})
+	assert.Equal(t, []string{
+		notEqualValueStr[int64]("DataPoint {A=true} Value", 3, 1),
+		"unexpected data point with attributes {B=true}: 2",
+	}, r)
+
+	attrC := attribute.NewSet(attribute.Bool("C", true))
+	r = dataPointValues(sum.DataPoints, map[attribute.Set]int64{attrA: 1, attrB: 2, attrC: 3, *attribute.EmptySet(): 4}, config{})
+	assert.Equal(t, []string{
+		"no data point with attributes {C=true}",
+		"no data point with attributes {}",
+	}, r)
+
+	sum.DataPoints = append(sum.DataPoints, sum.DataPoints[0])
+	r = dataPointValues(sum.DataPoints, map[attribute.Set]int64{attrA: 1, attrB: 2}, config{})
+	assert.Equal(t, []string{"duplicate data point with attributes {A=true}"}, r)
+
+	assert.False(t, AssertSumValues(new(recordingT), sum, map[attribute.Set]int64{attrA: 1, attrB: 2}))
+}
+
+func TestAssertGaugeValues(t *testing.T) {
+	gauge := metricdata.Gauge[float64]{
+		DataPoints: []metricdata.DataPoint[float64]{{Attributes: attrA, Value: 1.5}},
+	}
+	assert.True(t, AssertGaugeValues(t, gauge, map[attribute.Set]float64{attrA: 1.5}))
+	assert.True(t, AssertGaugeValues(t, gauge, map[attribute.Set]float64{attrA: 1.4}, WithTolerance(0.2)))
+	assert.False(t, AssertGaugeValues(new(recordingT), gauge, map[attribute.Set]float64{attrA: 1.4}))
+	assert.False(t, AssertGaugeValues(new(recordingT), gauge, map[attribute.Set]float64{}))
+}
+
+func TestRequireNonZeroExemplarTraceContext(t *testing.T) {
+	cfg := newConfig([]Option{RequireNonZeroExemplarTraceContext()})
+
+	other := exemplarInt64A
+	other.SpanID = []byte{0, 0, 0, 0, 0, 0, 0, 2}
+	other.TraceID = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}
+	assert.Len(t, equalExemplars(exemplarInt64A, other, config{}), 2)
+	assert.Empty(t, equalExemplars(exemplarInt64A, other, cfg))
+	assert.True(t, eqExemplars(exemplarInt64A, other, cfg))
+
+	// Only the actual trace context is required to be set.
+	unset := exemplarInt64A
+	unset.SpanID, unset.TraceID = nil, nil
+	assert.Empty(t, equalExemplars(unset, exemplarInt64A, cfg))
+
+	zero := exemplarInt64A
+	zero.SpanID = make([]byte, 8)
+	zero.TraceID = nil
+	assert.Equal(t, []string{
+		"SpanID not set:\nexpected: non-zero\nactual: [0 0 0 0 0 0 0 0]",
+		"TraceID not set:\nexpected: non-zero\nactual: []",
+	}, equalExemplars(exemplarInt64A, zero, cfg))
+	assert.False(t, eqExemplars(exemplarInt64A, zero, cfg))
+
+	zero.SpanID = other.SpanID
+	assert.Len(t, equalExemplars(exemplarInt64A, zero, cfg), 1)
+}
+
+func TestAggregationTypesStr(t *testing.T) {
+	r := equalAggregations(sumInt64A, sumFloat64A, config{})
+	assert.Equal(t, []string{
+		"Aggregation types not equal:\nexpected: metricdata.Sum[int64]\nactual: metricdata.Sum[float64]\n" +
+			"Aggregation kinds match (Sum) but numeric types differ: int64 vs float64",
+	}, r)
+
+	r = equalAggregations(sumFloat64A, gaugeFloat64A, config{})
+	assert.Equal(t, []string{
+		"Aggregation types not equal:\nexpected: metricdata.Sum[float64]\nactual: metricdata.Gauge[float64]\n" +
+			"numeric types match (float64) but Aggregation kinds differ: Sum vs Gauge",
+	}, r)
+
+	r = equalAggregations(sumInt64A, gaugeFloat64A, config{})
+	assert.Equal(t, []string{
+		"Aggregation types not equal:\nexpected: metricdata.Sum[int64]\nactual: metricdata.Gauge[float64]",
+	}, r)
+}
+
+func TestAssertTimestampsWithin(t *testing.T) {
+	window := func(rm metricdata.ResourceMetrics, start, end time.Time) []string {
+		return timestampsWithinResourceMetrics(rm, start, end)
+	}
+	before, after := startA.Add(-time.Hour), endB.Add(time.Hour)
+	assert.True(t, AssertTimestampsWithin(t, allAggregationsResourceMetrics, before, after))
+	assert.True(t, AssertTimestampsWithin(t, metricdata.ResourceMetrics{}, after, before))
+
+	// Every kind of data point is checked.
+	r := window(allAggregationsResourceMetrics, endB.Add(time.Nanosecond), after)
+	assert.NotEmpty(t, r)
+	for _, m := range allAggregationsResourceMetrics.ScopeMetrics[0].Metrics {
+		if n, _ := dataPointsLen(m.Data); n == 0 {
+			continue
+		}
+		assert.Contains(t, r, fmt.Sprintf("Scope %s Metric %s:", allAggregationsResourceMetrics.ScopeMetrics[0].Scope.Name, m.Name))
+	}
+
+	sum := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{
+		{Attributes: attrA, StartTime: startA, Time: endA},
+		{Attributes: attrB, Time: endA},
+	}}
+	rm := metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{
+		Metrics: []metricdata.Metrics{{Name: "sum", Data: sum}},
+	}}}
+	r = window(rm, startA.Add(time.Nanosecond), endA)
+	assert.Equal(t, []string{
+		"Scope  Metric sum:",
+		fmt.Sprintf(
+			"DataPoint {A=true} StartTime %s not within [%s, %s]",
+			startA.Format(time.RFC3339Nano), startA.Add(time.Nanosecond).Format(time.RFC3339Nano), endA.Format(time.RFC3339Nano),
+		),
+	}, r, "zero StartTime not checked")
+
+	r = window(rm, startA, endA.Add(-time.Nanosecond))
+	assert.Len(t, r, 3, "Time of both data points")
+	assert.False(t, AssertTimestampsWithin(new(recordingT), rm, startA, startA))
+}
+
+func TestAssertEqualSingleDataPoint(t *testing.T) {
+	// Data points are compared directly, without wrapping them in an
+	// aggregation.
+	assert.True(t, AssertEqual(t, dataPointInt64A, dataPointInt64A))
+	assert.True(t, AssertEqual(t, histogramDataPointFloat64A, histogramDataPointFloat64A))
+	assert.True(t, AssertEqual(t, exponentialHistogramDataPointInt64A, exponentialHistogramDataPointInt64A))
+
+	rT := new(recordingT)
+	assert.False(t, AssertEqual(rT, histogramDataPointFloat64A, histogramDataPointFloat64B))
+	require.Len(t, rT.errors, 1)
+	assert.Equal(t, fmt.Sprint(equalHistogramDataPoints(histogramDataPointFloat64A, histogramDataPointFloat64B, newConfig(nil))), fmt.Sprint(rT.errors[0][0]))
+}
+
+func TestNormalizeBucketCounts(t *testing.T) {
+	full := metricdata.HistogramDataPoint[int64]{
+		Attributes:   attrA,
+		StartTime:    startA,
+		Time:         endA,
+		Count:        100,
+		Bounds:       []float64{0, 10},
+		BucketCounts: []uint64{25, 50, 25},
+		Sum:          1000,
+	}
+	sampled := full
+	sampled.Count = 10
+	sampled.BucketCounts = []uint64{2, 6, 2}
+	sampled.Sum = 100
+
+	assert.NotEmpty(t, equalHistogramDataPoints(full, sampled, newConfig(nil)))
+	normalized := newConfig([]Option{NormalizeBucketCounts()})
+	r := equalHistogramDataPoints(full, sampled, normalized)
+	assert.Equal(t, []string{notEqualStr("BucketCounts", full.BucketCounts, sampled.BucketCounts)}, r, "Count and Sum compared")
+	tolerant := newConfig([]Option{NormalizeBucketCounts(), WithTolerance(0.1)})
+	assert.Empty(t, equalHistogramDataPoints(full, sampled, tolerant))
+	assert.True(t, eqHistogramDataPoints(full, sampled, tolerant))
+	assert.False(t, eqHistogramDataPoints(full, sampled, normalized))
+
+	empty := full
+	empty.Count = 0
+	empty.BucketCounts = []uint64{0, 0, 0}
+	other := empty
+	other.BucketCounts = []uint64{1, 0, 0}
+	assert.Empty(t, equalHistogramDataPoints(empty, other, normalized), "zero Count fractions are zero")
+
+	expFull := exponentialHistogramDataPointInt64A
+	expFull.Count = 8
+	expFull.ZeroCount = 2
+	expFull.PositiveBucket = metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{4, 2}}
+	expFull.NegativeBucket = metricdata.ExponentialBucket{}
+	expSampled := expFull
+	expSampled.Count = 4
+	expSampled.ZeroCount = 1
+	expSampled.PositiveBucket = metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{2, 1}}
+	expSampled.Sum = expFull.Sum / 2
+
+	assert.NotEmpty(t, equalExponentialHistogramDataPoints(expFull, expSampled, newConfig(nil)))
+	assert.Empty(t, equalExponentialHistogramDataPoints(expFull, expSampled, normalized))
+	assert.True(t, eqExponentialHistogramDataPoints(expFull, expSampled, normalized))
+
+	expSampled.PositiveBucket = metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{1, 2}}
+	assert.Len(t, equalExponentialHistogramDataPoints(expFull, expSampled, normalized), 3, "header and two differing counts")
+	assert.False(t, eqExponentialHistogramDataPoints(expFull, expSampled, normalized))
+
+	assert.Empty(t, equalExponentialBuckets(
+		metricdata.ExponentialBucket{Counts: []uint64{1, 3}},
+		metricdata.ExponentialBucket{Counts: []uint64{2, 6}},
+		normalized,
+	), "standalone buckets normalized by their total")
+}
+
+func TestEqualScopes(t *testing.T) {
+	a := instrumentation.Scope{Name: "a", Version: "v0.1.0", SchemaURL: "https://example.com/1"}
+	assert.Empty(t, equalScopes(a, a, config{}))
+
+	b := instrumentation.Scope{Name: "a", Version: "v0.2.0", SchemaURL: "https://example.com/2"}
+	assert.Equal(t, []string{
+		notEqualStr("Scope Version", a.Version, b.Version),
+		notEqualStr("Scope SchemaURL", a.SchemaURL, b.SchemaURL),
+	}, equalScopes(a, b, config{}))
+
+	cfg := newConfig([]Option{IgnoreScopeVersion()})
+	assert.Equal(t, []string{notEqualStr("Scope SchemaURL", a.SchemaURL, b.SchemaURL)}, equalScopes(a, b, cfg))
+
+	smA := metricdata.ScopeMetrics{Scope: a, Metrics: []metricdata.Metrics{metricsA}}
+	smB := smA
+	smB.Scope.Version = b.Version
+	assert.False(t, AssertEqual(new(recordingT), smA, smB))
+	assert.True(t, AssertEqual(t, smA, smB, IgnoreScopeVersion()))
+	assert.True(t, EqualFast(smA, smB, IgnoreScopeVersion()))
+
+	rmA := metricdata.ResourceMetrics{Resource: resourceMetricsA.Resource, ScopeMetrics: []metricdata.ScopeMetrics{smA}}
+	rmB := metricdata.ResourceMetrics{Resource: resourceMetricsA.Resource, ScopeMetrics: []metricdata.ScopeMetrics{smB}}
+	assert.True(t, AssertEqual(t, rmA, rmB, IgnoreScopeVersion()))
+}
+
+func TestAssertEqualIgnoreAttributeKeys(t *testing.T) {
+	method := attribute.String("http.method", "GET")
+	dpA := metricdata.DataPoint[int64]{
+		Attributes: attribute.NewSet(method, attribute.Int("pid", 1)),
+		Value:      1,
+	}
+	dpB := metricdata.DataPoint[int64]{
+		Attributes: attribute.NewSet(method, attribute.Int("pid", 2), attribute.String("host", "b")),
+		Value:      1,
+	}
+	assert.Len(t, equalDataPoints(dpA, dpB, config{}), 1, "Attributes should differ")
+	assert.Len(t, equalDataPoints(dpA, dpB, newConfig([]Option{IgnoreAttributeKeys("pid")})), 1, "host should be compared")
+
+	// Keys are combined.
+	opts := []Option{IgnoreAttributeKeys("pid"), IgnoreAttributeKeys("host")}
+	AssertEqual(t, dpA, dpB, opts...)
+	assert.True(t, EqualFast(dpA, dpB, opts...))
+
+	dpC := dpB
+	dpC.Attributes = attribute.NewSet(attribute.String("http.method", "POST"))
+	assert.Len(t, equalDataPoints(dpA, dpC, newConfig(opts)), 1, "other attributes should differ")
+
+	// Ignored keys take precedence over matched keys.
+	cfg := newConfig([]Option{MatchAttributeKeys("http.method", "pid"), IgnoreAttributeKeys("pid")})
+	assert.Empty(t, equalDataPoints(dpA, dpB, cfg))
+
+	sumA := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{dpA}}
+	sumB := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{dpB}}
+	AssertEqual(t, sumA, sumB, opts...)
+}
+
+func TestCompareHistogramDistributions(t *testing.T) {
+	hdpA := metricdata.HistogramDataPoint[int64]{
+		Attributes:   attrA,
+		Count:        6,
+		Bounds:       []float64{0, 5, 10, 50},
+		BucketCounts: []uint64{1, 2, 1, 2, 0},
+		Sum:          40,
+	}
+	hdpB := hdpA
+	hdpB.Bounds = []float64{0, 10, 100}
+	hdpB.BucketCounts = []uint64{1, 3, 2, 0}
+	histA := metricdata.Histogram[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.HistogramDataPoint[int64]{hdpA},
+	}
+	histB := histA
+	histB.DataPoints = []metricdata.HistogramDataPoint[int64]{hdpB}
+
+	opt := CompareHistogramDistributions()
+	assert.False(t, AssertEqual(new(recordingT), histA, histB))
+	assert.True(t, AssertEqual(t, histA, histB, opt))
+	assert.True(t, EqualFast(histA, histB, opt))
+
+	// Attributes are still compared.
+	hdpB.Attributes = attrB
+	histB.DataPoints = []metricdata.HistogramDataPoint[int64]{hdpB}
+	assert.False(t, AssertEqual(new(recordingT), histA, histB, opt))
+	assert.False(t, EqualFast(histA, histB, opt))
+
+	hdpB.Attributes = attrA
+	hdpB.Sum = 41
+	assert.Len(t, equalHistogramDataPoints(hdpA, hdpB, newConfig([]Option{opt})), 1, "Sum should differ")
+	assert.Empty(t, equalHistogramDataPoints(hdpA, hdpB, newConfig([]Option{opt, WithTolerance(1)})))
+
+	// Distributions of different Counts compared by their shape.
+	hdpB = hdpA
+	hdpB.Count = 12
+	hdpB.Bounds = []float64{0, 10, 100}
+	hdpB.BucketCounts = []uint64{2, 6, 4, 0}
+	hdpB.Sum = 80
+	histB.DataPoints = []metricdata.HistogramDataPoint[int64]{hdpB}
+	assert.False(t, AssertEqual(new(recordingT), histA, histB, opt))
+	assert.True(t, AssertEqual(t, histA, histB, opt, NormalizeBucketCounts()))
+	assert.True(t, AssertHistogramDistributionEqual(t, hdpA, hdpB, NormalizeBucketCounts()))
+}
+
+func TestWithOrderedCompare(t *testing.T) {
+	sumABC := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B, dataPointInt64C},
+	}
+	sumACB := sumABC
+	sumACB.DataPoints = []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64C, dataPointInt64B}
+
+	AssertEqual(t, sumABC, sumABC, WithOrderedCompare())
+	equal, _ := Equal(sumABC, sumACB)
+	assert.True(t, equal)
+	equal, _ = Equal(sumABC, sumACB, WithOrderedCompare())
+	assert.False(t, equal)
+	assert.False(t, EqualFast(sumABC, sumACB, WithOrderedCompare()))
+
+	r := equalSums(sumABC, sumACB, newConfig([]Option{WithOrderedCompare()}))
+	require.Len(t, r, 1)
+	assert.Contains(t, r[0], "Sum DataPoints not equal at index 1:")
+
+	short := sumABC
+	short.DataPoints = sumABC.DataPoints[:2]
+	r = equalSums(sumABC, short, newConfig([]Option{WithOrderedCompare()}))
+	require.Len(t, r, 1)
+	assert.Contains(t, r[0], "Sum DataPoints not equal at index 2:")
+
+	r = equalSums(sumABC, sumACB, newConfig(nil))
+	assert.Empty(t, r)
+}
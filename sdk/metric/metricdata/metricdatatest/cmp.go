@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// metricdataPkg is the import path of the metricdata package.
+var metricdataPkg = reflect.TypeOf(metricdata.ResourceMetrics{}).PkgPath()
+
+// CmpOptions returns the cmp.Options to compare the concrete data-types from
+// the metricdata package with cmp.Equal or cmp.Diff. The comparison has the
+// same semantics as AssertEqual: ScopeMetrics, Metrics, data points, and
+// Exemplars are compared independent of their order, and attribute sets are
+// compared by their contents.
+//
+// The IgnoreTimestamp, IgnoreExemplars, IgnoreExemplarFilteredAttributes,
+// IgnoreValue, IgnoreHistogramSum, IgnoreHistogramMinMax, MatchAttributeKeys,
+// IgnoreAttributeKeys, WithTolerance, WithRelativeTolerance, and
+// WithValueComparer opts are honored. All other opts are ignored.
+//
+// Slices are sorted before they are compared. Data points with equal
+// attributes are not sorted any further, therefore their order still needs
+// to match.
+func CmpOptions(opts ...Option) []cmp.Option {
+	cfg := newConfig(opts)
+
+	out := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmp.Comparer(func(a, b attribute.Set) bool {
+			return len(equalAttributes(a, b, cfg)) == 0
+		}),
+		cmp.Comparer(func(a, b metricdata.Extrema[int64]) bool {
+			return eqExtrema(a, b, cfg)
+		}),
+		cmp.Comparer(func(a, b metricdata.Extrema[float64]) bool {
+			return eqExtrema(a, b, cfg)
+		}),
+		cmp.Comparer(func(a, b []attribute.KeyValue) bool {
+			return eqKeyValues(a, b, cfg)
+		}),
+		metricdataFieldFilter(cmp.Options{
+			cmp.Comparer(func(a, b int64) bool { return equalValues(a, b, cfg) }),
+			cmp.Comparer(func(a, b float64) bool { return equalValues(a, b, cfg) }),
+		}, "Value", "Sum"),
+		cmpopts.SortSlices(func(a, b metricdata.ScopeMetrics) bool {
+			return lessScope(a.Scope, b.Scope)
+		}),
+		cmpopts.SortSlices(func(a, b metricdata.Metrics) bool {
+			return a.Name < b.Name
+		}),
+	}
+	out = append(out, cmpSortDataPoints[int64](cfg)...)
+	out = append(out, cmpSortDataPoints[float64](cfg)...)
+
+	var ignored []string
+	if cfg.ignoreTimestamp {
+		ignored = append(ignored, "StartTime", "Time")
+	}
+	if cfg.ignoreExemplars {
+		ignored = append(ignored, "Exemplars")
+	}
+	if cfg.ignoreExemplarFilteredAttributes {
+		ignored = append(ignored, "FilteredAttributes")
+	}
+	if cfg.ignoreValue {
+		ignored = append(ignored,
+			"Value", "Count", "Sum", "Bounds", "BucketCounts", "Min", "Max",
+			"Scale", "ZeroCount", "PositiveBucket", "NegativeBucket", "ZeroThreshold",
+		)
+	}
+	if cfg.ignoreHistogramSum {
+		ignored = append(ignored, "Sum")
+	}
+	if cfg.ignoreHistogramMinMax {
+		ignored = append(ignored, "Min", "Max")
+	}
+	if len(ignored) > 0 {
+		out = append(out, metricdataFieldFilter(cmp.Ignore(), ignored...))
+	}
+	return out
+}
+
+// metricdataFieldFilter returns opt applied only to the fields of types from
+// the metricdata package with one of names.
+func metricdataFieldFilter(opt cmp.Option, names ...string) cmp.Option {
+	return cmp.FilterPath(func(p cmp.Path) bool {
+		sf, ok := p.Last().(cmp.StructField)
+		if !ok || p.Index(-2).Type().PkgPath() != metricdataPkg {
+			return false
+		}
+		for _, name := range names {
+			if sf.Name() == name {
+				return true
+			}
+		}
+		return false
+	}, opt)
+}
+
+// cmpSortDataPoints returns the cmp.Options sorting the data points and
+// Exemplars with number type N.
+func cmpSortDataPoints[N int64 | float64](cfg config) []cmp.Option {
+	less := func(a, b attribute.Set) bool {
+		if cfg.attributeFilter != nil {
+			a, _ = a.Filter(cfg.attributeFilter)
+			b, _ = b.Filter(cfg.attributeFilter)
+		}
+		enc := attribute.DefaultEncoder()
+		return a.Encoded(enc) < b.Encoded(enc)
+	}
+	return []cmp.Option{
+		cmpopts.SortSlices(func(a, b metricdata.DataPoint[N]) bool {
+			return less(a.Attributes, b.Attributes)
+		}),
+		cmpopts.SortSlices(func(a, b metricdata.HistogramDataPoint[N]) bool {
+			return less(a.Attributes, b.Attributes)
+		}),
+		cmpopts.SortSlices(func(a, b metricdata.ExponentialHistogramDataPoint[N]) bool {
+			return less(a.Attributes, b.Attributes)
+		}),
+		cmpopts.SortSlices(func(a, b metricdata.Exemplar[N]) bool {
+			if c := bytes.Compare(a.TraceID, b.TraceID); c != 0 {
+				return c < 0
+			}
+			return bytes.Compare(a.SpanID, b.SpanID) < 0
+		}),
+	}
+}
+
+// lessScope returns if the Scope a sorts before b.
+func lessScope(a, b instrumentation.Scope) bool {
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	if a.Version != b.Version {
+		return a.Version < b.Version
+	}
+	return a.SchemaURL < b.SchemaURL
+}
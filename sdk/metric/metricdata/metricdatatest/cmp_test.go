@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestCmpOptions(t *testing.T) {
+	assert.Empty(t, cmp.Diff(resourceMetricsA, resourceMetricsA, CmpOptions()...))
+	assert.Empty(t, cmp.Diff(allAggregationsResourceMetrics, allAggregationsResourceMetrics, CmpOptions()...))
+	assert.NotEmpty(t, cmp.Diff(resourceMetricsA, resourceMetricsB, CmpOptions()...))
+
+	assert.NotEmpty(t, cmp.Diff(resourceMetricsA, resourceMetricsC, CmpOptions()...))
+	assert.Empty(t, cmp.Diff(resourceMetricsA, resourceMetricsC, CmpOptions(IgnoreTimestamp())...))
+
+	sumAB := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B},
+	}
+	sumBA := sumAB
+	sumBA.DataPoints = []metricdata.DataPoint[int64]{dataPointInt64B, dataPointInt64A}
+	assert.Empty(t, cmp.Diff(sumAB, sumBA, CmpOptions()...), "data point order")
+
+	a := metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{{Value: 1}}}
+	b := metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{{Value: 1.05}}}
+	assert.NotEmpty(t, cmp.Diff(a, b, CmpOptions()...))
+	assert.Empty(t, cmp.Diff(a, b, CmpOptions(WithTolerance(0.1))...))
+	assert.Empty(t, cmp.Diff(a, b, CmpOptions(IgnoreValue())...))
+
+	hDP := histogramDataPointInt64A
+	hDP.Count, hDP.Sum = hDP.Count+1, hDP.Sum+1
+	hist := histogramInt64A
+	hist.DataPoints = []metricdata.HistogramDataPoint[int64]{hDP}
+	assert.NotEmpty(t, cmp.Diff(histogramInt64A, hist, CmpOptions()...))
+	assert.Empty(t, cmp.Diff(histogramInt64A, hist, CmpOptions(IgnoreValue())...))
+
+	hDP = histogramDataPointInt64A
+	hDP.Attributes = attrB
+	hist.DataPoints = []metricdata.HistogramDataPoint[int64]{hDP}
+	assert.NotEmpty(t, cmp.Diff(histogramInt64A, hist, CmpOptions()...))
+	assert.Empty(t, cmp.Diff(histogramInt64A, hist, CmpOptions(IgnoreAttributeKeys("A", "B"))...))
+}
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Collector collects metric data. It is implemented by the Readers of
+// go.opentelemetry.io/otel/sdk/metric.
+type Collector interface {
+	Collect(context.Context, *metricdata.ResourceMetrics) error
+}
+
+// CollectAndAssert collects the metric data of reader and asserts it is equal
+// to expected. The assertion fails if reader returns an error. Otherwise, the
+// comparison is the same as AssertEqual.
+func CollectAndAssert(t TestingT, ctx context.Context, reader Collector, expected metricdata.ResourceMetrics, opts ...Option) bool {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Error([]string{fmt.Sprintf("Collect failed: %v", err)})
+		return false
+	}
+	return AssertEqual(t, expected, rm, opts...)
+}
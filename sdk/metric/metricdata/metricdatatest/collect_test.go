@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type collectorFunc func(context.Context, *metricdata.ResourceMetrics) error
+
+func (f collectorFunc) Collect(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return f(ctx, rm)
+}
+
+func TestCollectAndAssert(t *testing.T) {
+	ctx := context.Background()
+	reader := collectorFunc(func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+		*rm = resourceMetricsA
+		return nil
+	})
+
+	assert.True(t, CollectAndAssert(t, ctx, reader, resourceMetricsA))
+	assert.True(t, CollectAndAssert(t, ctx, reader, resourceMetricsC, IgnoreTimestamp()))
+
+	rT := new(recordingT)
+	assert.False(t, CollectAndAssert(rT, ctx, reader, resourceMetricsB))
+	require.Len(t, rT.errors, 1)
+
+	errReader := collectorFunc(func(context.Context, *metricdata.ResourceMetrics) error {
+		return errors.New("shutdown")
+	})
+	rT = new(recordingT)
+	assert.False(t, CollectAndAssert(rT, ctx, errReader, metricdata.ResourceMetrics{}))
+	assert.Equal(t, [][]any{{[]string{"Collect failed: shutdown"}}}, rT.errors)
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"os"
+	"strings"
+)
+
+const (
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorReset = "\x1b[0m"
+)
+
+// colorEnabled returns if output can be colored. It is a variable so it can
+// be overridden in tests.
+var colorEnabled = func() bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	fi, err := os.Stdout.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// WithColorDiff renders the reasons of a failed assertion with expected
+// values in red and actual values in green, similar to the output of
+// testify.
+//
+// Color is only used if the standard output is a terminal and the NO_COLOR
+// environment variable is not set, otherwise the reasons are rendered as
+// plain text.
+func WithColorDiff() Option {
+	return fnOption(func(cfg config) config {
+		cfg.colorDiff = true
+		return cfg
+	})
+}
+
+// render returns reasons as they are reported based on cfg.
+func (cfg config) render(reasons []string) []string {
+	if !cfg.colorDiff || !colorEnabled() {
+		return reasons
+	}
+	colored := make([]string, len(reasons))
+	for i, r := range reasons {
+		colored[i] = colorize(r)
+	}
+	return colored
+}
+
+// colorize returns reason with the expected and actual lines colored.
+//
+// The "expected:" and "actual:" lines of notEqualStr, and the "only in
+// expected:" and "only in actual:" lines of attributesDiffStr, are colored
+// individually. All lines following a "missing expected values:" or
+// "unexpected additional values:" header of compareDiff are colored until
+// the end of the reason or the next header.
+func colorize(reason string) string {
+	lines := strings.Split(reason, "\n")
+	var block string
+	for i, l := range lines {
+		switch {
+		case l == "":
+			// Do not color empty lines.
+		case l == "missing expected values:":
+			block = colorRed
+		case l == "unexpected additional values:":
+			block = colorGreen
+		case block != "":
+			lines[i] = block + l + colorReset
+		case strings.HasPrefix(l, "expected: "), strings.HasPrefix(l, "only in expected: "):
+			lines[i] = colorRed + l + colorReset
+		case strings.HasPrefix(l, "actual: "), strings.HasPrefix(l, "only in actual: "):
+			lines[i] = colorGreen + l + colorReset
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setColorEnabled(t *testing.T, enabled bool) {
+	orig := colorEnabled
+	t.Cleanup(func() { colorEnabled = orig })
+	colorEnabled = func() bool { return enabled }
+}
+
+func TestColorize(t *testing.T) {
+	reason := notEqualStr("Value", 1, 2)
+	want := "Value not equal:\n" +
+		colorRed + "expected: 1" + colorReset + "\n" +
+		colorGreen + "actual: 2" + colorReset
+	assert.Equal(t, want, colorize(reason))
+
+	reason = compareDiff(config{}, []int{1}, []int{2, 3})
+	want = "missing expected values:\n" +
+		colorRed + "1" + colorReset + "\n" +
+		"unexpected additional values:\n" +
+		colorGreen + "2" + colorReset + "\n" +
+		colorGreen + "3" + colorReset + "\n"
+	assert.Equal(t, want, colorize(reason))
+}
+
+func TestWithColorDiff(t *testing.T) {
+	setColorEnabled(t, true)
+
+	rt := new(recordingT)
+	assert.False(t, AssertEqual(rt, sumInt64A, sumInt64B, WithColorDiff()))
+	require.Len(t, rt.errors, 1)
+	assert.Equal(t, []string{colorize(equalSums(sumInt64A, sumInt64B, config{})[0])}, rt.errors[0][0])
+
+	rt = new(recordingT)
+	assert.False(t, AssertEqual(rt, sumInt64A, sumInt64B))
+	require.Len(t, rt.errors, 1)
+	assert.NotContains(t, rt.errors[0][0], colorReset, "colored without option")
+}
+
+func TestWithColorDiffNotTerminal(t *testing.T) {
+	setColorEnabled(t, false)
+
+	rt := new(recordingT)
+	assert.False(t, AssertEqual(rt, sumInt64A, sumInt64B, WithColorDiff()))
+	require.Len(t, rt.errors, 1)
+	assert.NotContains(t, rt.errors[0][0], colorReset, "colored when not a terminal")
+}
+
+func TestColorizeAttributesDiff(t *testing.T) {
+	reason := attributesDiffStr(attrA, attrB, config{})
+	want := "Attributes not equal:\n" +
+		colorRed + "only in expected: {A=true}" + colorReset + "\n" +
+		colorGreen + "only in actual: {B=true}" + colorReset + "\n" +
+		"different values: {}"
+	assert.Equal(t, want, colorize(reason))
+}
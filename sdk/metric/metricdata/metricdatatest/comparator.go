@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+// Comparator compares values of the concrete data-type T from the metricdata
+// package. The comparison is the same as Equal.
+//
+// A Comparator reuses the buffers it needs to match the elements of compared
+// values between calls to Compare. This reduces the allocations of comparing
+// many similar values in a loop, like in a benchmark.
+//
+// A Comparator is not safe for concurrent use.
+type Comparator[T Datatypes] struct {
+	cfg     config
+	scratch scratch
+}
+
+// NewComparator returns a Comparator that compares values using opts.
+func NewComparator[T Datatypes](opts ...Option) *Comparator[T] {
+	return &Comparator[T]{cfg: newConfig(opts)}
+}
+
+// Compare returns the reasons expected and actual are not equal. If they are
+// equal, the returned reasons will be empty.
+func (c *Comparator[T]) Compare(expected, actual T) []string {
+	cfg := c.cfg
+	cfg.scratch = &c.scratch
+	return equalDatatypes(expected, actual, cfg)
+}
+
+// scratch holds the buffers used to match the elements of compared slices.
+// Comparisons are nested, so multiple buffers can be in use at once. Released
+// buffers are kept to be reused by later comparisons.
+//
+// The zero value and a nil *scratch are ready to use. A nil *scratch does not
+// keep released buffers.
+type scratch struct {
+	pairs [][]int
+	seen  [][]bool
+}
+
+// get returns pairs and seen buffers of length n.
+func (s *scratch) get(n int) (pairs []int, seen []bool) {
+	if s == nil || len(s.pairs) == 0 {
+		return make([]int, n), make([]bool, n)
+	}
+
+	last := len(s.pairs) - 1
+	pairs, seen = s.pairs[last], s.seen[last]
+	s.pairs, s.seen = s.pairs[:last], s.seen[:last]
+	if cap(pairs) < n {
+		return make([]int, n), make([]bool, n)
+	}
+	return pairs[:n], seen[:n]
+}
+
+// put releases the pairs and seen buffers returned from get.
+func (s *scratch) put(pairs []int, seen []bool) {
+	if s == nil {
+		return
+	}
+	s.pairs = append(s.pairs, pairs)
+	s.seen = append(s.seen, seen)
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestComparator(t *testing.T) {
+	c := NewComparator[metricdata.ResourceMetrics]()
+	for i := 0; i < 3; i++ {
+		assert.Empty(t, c.Compare(resourceMetricsA, resourceMetricsA))
+		assert.Equal(t, equalResourceMetrics(resourceMetricsA, resourceMetricsB, config{}), c.Compare(resourceMetricsA, resourceMetricsB))
+		assert.Empty(t, c.Compare(allAggregationsResourceMetrics, allAggregationsResourceMetrics))
+	}
+
+	withOpts := NewComparator[metricdata.ResourceMetrics](IgnoreTimestamp())
+	assert.Empty(t, withOpts.Compare(resourceMetricsA, resourceMetricsC))
+	assert.NotEmpty(t, c.Compare(resourceMetricsA, resourceMetricsC))
+}
+
+func TestScratch(t *testing.T) {
+	var s scratch
+	pairs, seen := s.get(2)
+	assert.Len(t, pairs, 2)
+	assert.Len(t, seen, 2)
+	s.put(pairs, seen)
+
+	// Released buffers are reused.
+	reused, _ := s.get(1)
+	assert.Len(t, reused, 1)
+	assert.Same(t, &pairs[0], &reused[0])
+
+	// Nested use needs distinct buffers.
+	other, _ := s.get(1)
+	other[0] = 1
+	assert.Equal(t, 0, reused[0])
+
+	var nilScratch *scratch
+	pairs, seen = nilScratch.get(3)
+	assert.Len(t, pairs, 3)
+	assert.Len(t, seen, 3)
+	nilScratch.put(pairs, seen)
+}
+
+func BenchmarkComparator(b *testing.B) {
+	c := NewComparator[metricdata.ResourceMetrics]()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_ = c.Compare(allAggregationsResourceMetrics, allAggregationsResourceMetrics)
+	}
+}
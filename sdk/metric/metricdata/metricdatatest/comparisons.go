@@ -17,10 +17,18 @@ package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata
 import (
 	"bytes"
 	"fmt"
+	"math"
+	"path"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // equalResourceMetrics returns reasons ResourceMetrics are not equal. If they
@@ -29,48 +37,142 @@ import (
 // The ScopeMetrics each ResourceMetrics contains are compared based on
 // containing the same ScopeMetrics, not the order they are stored in.
 func equalResourceMetrics(a, b metricdata.ResourceMetrics, cfg config) (reasons []string) {
-	if !a.Resource.Equal(b.Resource) {
-		reasons = append(reasons, notEqualStr("Resources", a.Resource, b.Resource))
-	}
+	reasons = append(reasons, equalResources(a.Resource, b.Resource, cfg)...)
 
-	r := compareDiff(diffSlices(
+	extraA, extraB := diffSlices(
+		cfg,
 		a.ScopeMetrics,
 		b.ScopeMetrics,
 		func(a, b metricdata.ScopeMetrics) bool {
 			r := equalScopeMetrics(a, b, cfg)
 			return len(r) == 0
 		},
-	))
+	)
+	r := compareDiff(cfg, extraA, extraB)
 	if r != "" {
 		reasons = append(reasons, fmt.Sprintf("ResourceMetrics ScopeMetrics not equal:\n%s", r))
 	}
 	return reasons
 }
 
+// equalResources returns reasons the Resources a and b are not equal. If they
+// are equal, the returned reasons will be empty.
+//
+// The schema URL and attributes are compared and reported separately. If cfg
+// has Resource attribute keys, only the attributes with those keys are
+// compared.
+func equalResources(a, b *resource.Resource, cfg config) (reasons []string) {
+	if cfg.resourceAttributeKeys == nil && a.Equal(b) && a.SchemaURL() == b.SchemaURL() {
+		// Avoid building attribute sets for the common case.
+		return nil
+	}
+
+	if a.SchemaURL() != b.SchemaURL() {
+		reasons = append(reasons, notEqualStr("Resource SchemaURL", a.SchemaURL(), b.SchemaURL()))
+	}
+	var filter attribute.Filter
+	if cfg.resourceAttributeKeys != nil {
+		filter = func(kv attribute.KeyValue) bool {
+			_, ok := cfg.resourceAttributeKeys[kv.Key]
+			return ok
+		}
+	}
+	aSet, _ := attribute.NewSetWithFiltered(a.Attributes(), filter)
+	bSet, _ := attribute.NewSetWithFiltered(b.Attributes(), filter)
+	if !aSet.Equals(&bSet) {
+		reasons = append(reasons, "Resource "+attributesDiffStr(aSet, bSet, cfg))
+	}
+	return reasons
+}
+
 // equalScopeMetrics returns reasons ScopeMetrics are not equal. If they are
 // equal, the returned reasons will be empty.
 //
 // The Metrics each ScopeMetrics contains are compared based on containing the
 // same Metrics, not the order they are stored in.
 func equalScopeMetrics(a, b metricdata.ScopeMetrics, cfg config) (reasons []string) {
-	if a.Scope != b.Scope {
-		reasons = append(reasons, notEqualStr("Scope", a.Scope, b.Scope))
-	}
+	reasons = append(reasons, equalScopes(a.Scope, b.Scope, cfg)...)
 
-	r := compareDiff(diffSlices(
-		a.Metrics,
-		b.Metrics,
-		func(a, b metricdata.Metrics) bool {
+	aMetrics, bMetrics := filterMetrics(a.Metrics, cfg), filterMetrics(b.Metrics, cfg)
+	var extraA, extraB []metricdata.Metrics
+	if cfg.metricKey != nil {
+		var r []string
+		r, extraA, extraB = pairSlices(cfg, aMetrics, bMetrics, cfg.metricKey, func(a, b metricdata.Metrics) []string {
+			return equalMetrics(a, b, cfg)
+		})
+		reasons = append(reasons, r...)
+	} else {
+		extraA, extraB = diffSlices(cfg, aMetrics, bMetrics, func(a, b metricdata.Metrics) bool {
 			r := equalMetrics(a, b, cfg)
 			return len(r) == 0
-		},
-	))
-	if r != "" {
+		})
+	}
+	if r := compareDiff(cfg, extraA, extraB); r != "" {
 		reasons = append(reasons, fmt.Sprintf("ScopeMetrics Metrics not equal:\n%s", r))
 	}
+	if len(reasons) > 0 {
+		// Identify the scope so reasons are traceable in large comparisons.
+		reasons = append([]string{fmt.Sprintf("Scope %s:", a.Scope.Name)}, reasons...)
+	}
+	return reasons
+}
+
+// equalScopes returns reasons the instrumentation Scopes a and b are not
+// equal. If they are equal, the returned reasons will be empty.
+//
+// Each field is compared and reported separately. The Version is not
+// compared if cfg ignores it.
+func equalScopes(a, b instrumentation.Scope, cfg config) (reasons []string) {
+	if a.Name != b.Name {
+		reasons = append(reasons, notEqualStr("Scope Name", a.Name, b.Name))
+	}
+	if !cfg.ignoreScopeVersion && a.Version != b.Version {
+		reasons = append(reasons, notEqualStr("Scope Version", a.Version, b.Version))
+	}
+	if a.SchemaURL != b.SchemaURL {
+		reasons = append(reasons, notEqualStr("Scope SchemaURL", a.SchemaURL, b.SchemaURL))
+	}
 	return reasons
 }
 
+// filterMetrics returns the Metrics of metrics with a name matching the
+// patterns of cfg. If cfg has no patterns, metrics is returned.
+func filterMetrics(metrics []metricdata.Metrics, cfg config) []metricdata.Metrics {
+	if len(cfg.metricPatterns) == 0 {
+		return metrics
+	}
+	var matched []metricdata.Metrics
+	for _, m := range metrics {
+		if matchMetricPatterns(m, cfg) {
+			matched = append(matched, m)
+		}
+	}
+	return matched
+}
+
+// metricIndexes returns the indexes of the Metrics of metrics filterMetrics
+// returns.
+func metricIndexes(metrics []metricdata.Metrics, cfg config) []int {
+	idx := make([]int, 0, len(metrics))
+	for i, m := range metrics {
+		if len(cfg.metricPatterns) == 0 || matchMetricPatterns(m, cfg) {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// matchMetricPatterns returns if the name of m matches a pattern of cfg.
+func matchMetricPatterns(m metricdata.Metrics, cfg config) bool {
+	for _, p := range cfg.metricPatterns {
+		// Patterns are validated by OnlyMetrics.
+		if ok, _ := path.Match(p, m.Name); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // equalMetrics returns reasons Metrics are not equal. If they are equal, the
 // returned reasons will be empty.
 func equalMetrics(a, b metricdata.Metrics, cfg config) (reasons []string) {
@@ -89,6 +191,10 @@ func equalMetrics(a, b metricdata.Metrics, cfg config) (reasons []string) {
 		reasons = append(reasons, "Metrics Data not equal:")
 		reasons = append(reasons, r...)
 	}
+	if len(reasons) > 0 {
+		// Identify the metric so reasons are traceable in large comparisons.
+		reasons = append([]string{fmt.Sprintf("Metric %s:", a.Name)}, reasons...)
+	}
 	return reasons
 }
 
@@ -103,7 +209,13 @@ func equalAggregations(a, b metricdata.Aggregation, cfg config) (reasons []strin
 	}
 
 	if reflect.TypeOf(a) != reflect.TypeOf(b) {
-		return []string{fmt.Sprintf("Aggregation types not equal:\nexpected: %T\nactual: %T", a, b)}
+		var ok bool
+		if cfg.numericTypeCoercion {
+			a, b, ok = coerceNumericTypes(a, b)
+		}
+		if !ok {
+			return []string{aggregationTypesStr(a, b)}
+		}
 	}
 
 	switch v := a.(type) {
@@ -143,6 +255,18 @@ func equalAggregations(a, b metricdata.Aggregation, cfg config) (reasons []strin
 			reasons = append(reasons, "Histogram not equal:")
 			reasons = append(reasons, r...)
 		}
+	case metricdata.Summary[int64]:
+		r := equalSummaries(v, b.(metricdata.Summary[int64]), cfg)
+		if len(r) > 0 {
+			reasons = append(reasons, "Summary not equal:")
+			reasons = append(reasons, r...)
+		}
+	case metricdata.Summary[float64]:
+		r := equalSummaries(v, b.(metricdata.Summary[float64]), cfg)
+		if len(r) > 0 {
+			reasons = append(reasons, "Summary not equal:")
+			reasons = append(reasons, r...)
+		}
 	case metricdata.ExponentialHistogram[int64]:
 		r := equalExponentialHistograms(v, b.(metricdata.ExponentialHistogram[int64]), cfg)
 		if len(r) > 0 {
@@ -156,27 +280,132 @@ func equalAggregations(a, b metricdata.Aggregation, cfg config) (reasons []strin
 			reasons = append(reasons, r...)
 		}
 	default:
+		if cmp, ok := lookupAggregationComparer(reflect.TypeOf(a)); ok {
+			return cmp(a, b)
+		}
 		reasons = append(reasons, fmt.Sprintf("Aggregation of unknown types %T", a))
 	}
 	return reasons
 }
 
+// aggregationTypesStr returns a reason the types of the Aggregations a and b
+// are not equal. It identifies if only the kind of the aggregations (e.g. Sum
+// or Gauge) or only their numeric type (int64 or float64) differs.
+func aggregationTypesStr(a, b metricdata.Aggregation) string {
+	msg := fmt.Sprintf("Aggregation types not equal:\nexpected: %T\nactual: %T", a, b)
+	aKind, aNum := splitTypeName(reflect.TypeOf(a))
+	bKind, bNum := splitTypeName(reflect.TypeOf(b))
+	switch {
+	case aKind == bKind && aNum != bNum:
+		msg += fmt.Sprintf("\nAggregation kinds match (%s) but numeric types differ: %s vs %s", aKind, aNum, bNum)
+	case aKind != bKind && aNum == bNum && aNum != "":
+		msg += fmt.Sprintf("\nnumeric types match (%s) but Aggregation kinds differ: %s vs %s", aNum, aKind, bKind)
+	}
+	return msg
+}
+
+// splitTypeName returns the name of the generic type t without its type
+// argument, and the type argument. For example, "Sum" and "int64" are
+// returned for metricdata.Sum[int64]. If t is not generic, num is empty.
+func splitTypeName(t reflect.Type) (kind, num string) {
+	kind, num, _ = strings.Cut(t.Name(), "[")
+	return kind, strings.TrimSuffix(num, "]")
+}
+
+// coerceNumericTypes returns a and b with any int64 Gauge or Sum converted to
+// its float64 equivalent. If a and b are not both Gauges or both Sums, a and b
+// are returned unchanged along with false.
+func coerceNumericTypes(a, b metricdata.Aggregation) (metricdata.Aggregation, metricdata.Aggregation, bool) {
+	switch a.(type) {
+	case metricdata.Gauge[int64], metricdata.Gauge[float64]:
+		fA, okA := toFloat64Gauge(a)
+		fB, okB := toFloat64Gauge(b)
+		if okA && okB {
+			return fA, fB, true
+		}
+	case metricdata.Sum[int64], metricdata.Sum[float64]:
+		fA, okA := toFloat64Sum(a)
+		fB, okB := toFloat64Sum(b)
+		if okA && okB {
+			return fA, fB, true
+		}
+	}
+	return a, b, false
+}
+
+// toFloat64Gauge returns agg as a Gauge[float64] and true if agg is a Gauge.
+func toFloat64Gauge(agg metricdata.Aggregation) (metricdata.Gauge[float64], bool) {
+	switch g := agg.(type) {
+	case metricdata.Gauge[float64]:
+		return g, true
+	case metricdata.Gauge[int64]:
+		return metricdata.Gauge[float64]{DataPoints: toFloat64DataPoints(g.DataPoints)}, true
+	}
+	return metricdata.Gauge[float64]{}, false
+}
+
+// toFloat64Sum returns agg as a Sum[float64] and true if agg is a Sum.
+func toFloat64Sum(agg metricdata.Aggregation) (metricdata.Sum[float64], bool) {
+	switch s := agg.(type) {
+	case metricdata.Sum[float64]:
+		return s, true
+	case metricdata.Sum[int64]:
+		return metricdata.Sum[float64]{
+			DataPoints:  toFloat64DataPoints(s.DataPoints),
+			Temporality: s.Temporality,
+			IsMonotonic: s.IsMonotonic,
+		}, true
+	}
+	return metricdata.Sum[float64]{}, false
+}
+
+func toFloat64DataPoints(dPts []metricdata.DataPoint[int64]) []metricdata.DataPoint[float64] {
+	if dPts == nil {
+		return nil
+	}
+	out := make([]metricdata.DataPoint[float64], len(dPts))
+	for i, dp := range dPts {
+		out[i] = metricdata.DataPoint[float64]{
+			Attributes: dp.Attributes,
+			StartTime:  dp.StartTime,
+			Time:       dp.Time,
+			Value:      float64(dp.Value),
+		}
+		if dp.Exemplars != nil {
+			out[i].Exemplars = make([]metricdata.Exemplar[float64], len(dp.Exemplars))
+			for j, e := range dp.Exemplars {
+				out[i].Exemplars[j] = metricdata.Exemplar[float64]{
+					FilteredAttributes: e.FilteredAttributes,
+					Time:               e.Time,
+					Value:              float64(e.Value),
+					SpanID:             e.SpanID,
+					TraceID:            e.TraceID,
+				}
+			}
+		}
+	}
+	return out
+}
+
 // equalGauges returns reasons Gauges are not equal. If they are equal, the
 // returned reasons will be empty.
 //
 // The DataPoints each Gauge contains are compared based on containing the
 // same DataPoints, not the order they are stored in.
 func equalGauges[N int64 | float64](a, b metricdata.Gauge[N], cfg config) (reasons []string) {
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.DataPoint[N]) bool {
-			r := equalDataPoints(a, b, cfg)
-			return len(r) == 0
-		},
-	))
+	reasons = append(reasons, duplicateSeries(cfg, a.DataPoints, b.DataPoints, func(dp metricdata.DataPoint[N]) attribute.Set {
+		return dp.Attributes
+	})...)
+
+	equal := func(a, b metricdata.DataPoint[N]) bool {
+		r := equalDataPoints(a, b, cfg)
+		return len(r) == 0
+	}
+	extraA, extraB := diffDataPoints(cfg, a.DataPoints, b.DataPoints, equal)
+	r := compareDiff(cfg, extraA, extraB)
 	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Gauge DataPoints not equal:\n%s", r))
+		at := mismatchAt(cfg, a.DataPoints, b.DataPoints, equal)
+		reasons = append(reasons, fmt.Sprintf("Gauge DataPoints not equal%s:\n%s", at, r))
 	}
 	return reasons
 }
@@ -187,23 +416,27 @@ func equalGauges[N int64 | float64](a, b metricdata.Gauge[N], cfg config) (reaso
 // The DataPoints each Sum contains are compared based on containing the same
 // DataPoints, not the order they are stored in.
 func equalSums[N int64 | float64](a, b metricdata.Sum[N], cfg config) (reasons []string) {
-	if a.Temporality != b.Temporality {
+	a, b = normalizeSum(a, cfg), normalizeSum(b, cfg)
+	if !cfg.ignoreTemporality && a.Temporality != b.Temporality {
 		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
 	}
-	if a.IsMonotonic != b.IsMonotonic {
+	if !cfg.ignoreMonotonicity && a.IsMonotonic != b.IsMonotonic {
 		reasons = append(reasons, notEqualStr("IsMonotonic", a.IsMonotonic, b.IsMonotonic))
 	}
 
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.DataPoint[N]) bool {
-			r := equalDataPoints(a, b, cfg)
-			return len(r) == 0
-		},
-	))
+	reasons = append(reasons, duplicateSeries(cfg, a.DataPoints, b.DataPoints, func(dp metricdata.DataPoint[N]) attribute.Set {
+		return dp.Attributes
+	})...)
+
+	equal := func(a, b metricdata.DataPoint[N]) bool {
+		r := equalDataPoints(a, b, cfg)
+		return len(r) == 0
+	}
+	extraA, extraB := diffDataPoints(cfg, a.DataPoints, b.DataPoints, equal)
+	r := compareDiff(cfg, extraA, extraB)
 	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Sum DataPoints not equal:\n%s", r))
+		at := mismatchAt(cfg, a.DataPoints, b.DataPoints, equal)
+		reasons = append(reasons, fmt.Sprintf("Sum DataPoints not equal%s:\n%s", at, r))
 	}
 	return reasons
 }
@@ -214,20 +447,77 @@ func equalSums[N int64 | float64](a, b metricdata.Sum[N], cfg config) (reasons [
 // The DataPoints each Histogram contains are compared based on containing the
 // same HistogramDataPoint, not the order they are stored in.
 func equalHistograms[N int64 | float64](a, b metricdata.Histogram[N], cfg config) (reasons []string) {
-	if a.Temporality != b.Temporality {
+	a, b = normalizeHistogram(a, cfg), normalizeHistogram(b, cfg)
+	if !cfg.ignoreTemporality && a.Temporality != b.Temporality {
 		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
 	}
 
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.HistogramDataPoint[N]) bool {
-			r := equalHistogramDataPoints(a, b, cfg)
-			return len(r) == 0
-		},
-	))
+	reasons = append(reasons, duplicateSeries(cfg, a.DataPoints, b.DataPoints, func(dp metricdata.HistogramDataPoint[N]) attribute.Set {
+		return dp.Attributes
+	})...)
+
+	equal := func(a, b metricdata.HistogramDataPoint[N]) bool {
+		r := equalHistogramDataPoints(a, b, cfg)
+		return len(r) == 0
+	}
+	extraA, extraB := diffDataPoints(cfg, a.DataPoints, b.DataPoints, equal)
+	r := compareDiff(cfg, extraA, extraB)
+	if r != "" {
+		at := mismatchAt(cfg, a.DataPoints, b.DataPoints, equal)
+		reasons = append(reasons, fmt.Sprintf("Histogram DataPoints not equal%s:\n%s", at, r))
+	}
+	return reasons
+}
+
+// equalSummaries returns reasons Summaries are not equal. If they are equal,
+// the returned reasons will be empty.
+func equalSummaries[N int64 | float64](a, b metricdata.Summary[N], cfg config) (reasons []string) {
+	if !cfg.ignoreTemporality && a.Temporality != b.Temporality {
+		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
+	}
+
+	reasons = append(reasons, duplicateSeries(cfg, a.DataPoints, b.DataPoints, func(dp metricdata.SummaryDataPoint[N]) attribute.Set {
+		return dp.Attributes
+	})...)
+
+	equal := func(a, b metricdata.SummaryDataPoint[N]) bool {
+		r := equalSummaryDataPoints(a, b, cfg)
+		return len(r) == 0
+	}
+	extraA, extraB := diffDataPoints(cfg, a.DataPoints, b.DataPoints, equal)
+	r := compareDiff(cfg, extraA, extraB)
 	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Histogram DataPoints not equal:\n%s", r))
+		at := mismatchAt(cfg, a.DataPoints, b.DataPoints, equal)
+		reasons = append(reasons, fmt.Sprintf("Summary DataPoints not equal%s:\n%s", at, r))
+	}
+	return reasons
+}
+
+// equalSummaryDataPoints returns reasons SummaryDataPoints are not equal. If
+// they are equal, the returned reasons will be empty.
+func equalSummaryDataPoints[N int64 | float64](a, b metricdata.SummaryDataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
+	reasons = append(reasons, equalAttributes(a.Attributes, b.Attributes, cfg)...)
+	if !cfg.ignoreTimestamp {
+		if !equalStartTimes(a.StartTime, b.StartTime, cfg) {
+			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+		}
+		if !a.Time.Equal(b.Time) {
+			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+		}
+	}
+	if !cfg.ignoreValue {
+		if a.Count != b.Count {
+			reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
+		}
+		if !eqExtrema(a.Min, b.Min, cfg) {
+			reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
+		}
+		if !eqExtrema(a.Max, b.Max, cfg) {
+			reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
+		}
+		if !equalValues(a.Sum, b.Sum, cfg) {
+			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
+		}
 	}
 	return reasons
 }
@@ -235,16 +525,10 @@ func equalHistograms[N int64 | float64](a, b metricdata.Histogram[N], cfg config
 // equalDataPoints returns reasons DataPoints are not equal. If they are
 // equal, the returned reasons will be empty.
 func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
-	if !a.Attributes.Equals(&b.Attributes) {
-		reasons = append(reasons, notEqualStr(
-			"Attributes",
-			a.Attributes.Encoded(attribute.DefaultEncoder()),
-			b.Attributes.Encoded(attribute.DefaultEncoder()),
-		))
-	}
+	reasons = append(reasons, equalAttributes(a.Attributes, b.Attributes, cfg)...)
 
 	if !cfg.ignoreTimestamp {
-		if !a.StartTime.Equal(b.StartTime) {
+		if !equalStartTimes(a.StartTime, b.StartTime, cfg) {
 			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
 		}
 		if !a.Time.Equal(b.Time) {
@@ -253,101 +537,140 @@ func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config
 	}
 
 	if !cfg.ignoreValue {
-		if a.Value != b.Value {
-			reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
+		if !equalDataPointValues(a.Value, b.Value, cfg) {
+			reasons = append(reasons, notEqualValueStr("Value", a.Value, b.Value))
 		}
 	}
 
-	if !cfg.ignoreExemplars {
-		r := compareDiff(diffSlices(
-			a.Exemplars,
-			b.Exemplars,
-			func(a, b metricdata.Exemplar[N]) bool {
-				r := equalExemplars(a, b, cfg)
-				return len(r) == 0
-			},
-		))
-		if r != "" {
-			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
-		}
-	}
+	reasons = append(reasons, equalExemplarSlices(a.Exemplars, b.Exemplars, cfg)...)
 	return reasons
 }
 
 // equalHistogramDataPoints returns reasons HistogramDataPoints are not equal.
 // If they are equal, the returned reasons will be empty.
 func equalHistogramDataPoints[N int64 | float64](a, b metricdata.HistogramDataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
-	if !a.Attributes.Equals(&b.Attributes) {
-		reasons = append(reasons, notEqualStr(
-			"Attributes",
-			a.Attributes.Encoded(attribute.DefaultEncoder()),
-			b.Attributes.Encoded(attribute.DefaultEncoder()),
-		))
-	}
+	reasons = append(reasons, equalAttributes(a.Attributes, b.Attributes, cfg)...)
 	if !cfg.ignoreTimestamp {
-		if !a.StartTime.Equal(b.StartTime) {
+		if !equalStartTimes(a.StartTime, b.StartTime, cfg) {
 			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
 		}
 		if !a.Time.Equal(b.Time) {
 			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
 	}
-	if !cfg.ignoreValue {
-		if a.Count != b.Count {
+	if !cfg.ignoreValue && cfg.histogramDistributions {
+		reasons = append(reasons, equalHistogramDistributions(a, b, cfg)...)
+	} else if !cfg.ignoreValue {
+		if !cfg.normalizeBucketCounts && a.Count != b.Count {
 			reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
 		}
-		if !equalSlices(a.Bounds, b.Bounds) {
-			reasons = append(reasons, notEqualStr("Bounds", a.Bounds, b.Bounds))
+		aBounds, aCounts := a.Bounds, a.BucketCounts
+		bBounds, bCounts := b.Bounds, b.BucketCounts
+		if cfg.ignoreTrailingZeroBuckets {
+			aBounds, aCounts = trimTrailingZeroBuckets(aBounds, aCounts)
+			bBounds, bCounts = trimTrailingZeroBuckets(bBounds, bCounts)
+		}
+		if !equalSlices(aBounds, bBounds) {
+			reasons = append(reasons, notEqualStr("Bounds", aBounds, bBounds))
 		}
-		if !equalSlices(a.BucketCounts, b.BucketCounts) {
-			reasons = append(reasons, notEqualStr("BucketCounts", a.BucketCounts, b.BucketCounts))
+		if !equalBucketCounts(aCounts, a.Count, bCounts, b.Count, cfg) {
+			reasons = append(reasons, notEqualStr("BucketCounts", aCounts, bCounts))
 		}
-		if !eqExtrema(a.Min, b.Min) {
+		if !cfg.ignoreHistogramMinMax && !eqExtrema(a.Min, b.Min, cfg) {
 			reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
 		}
-		if !eqExtrema(a.Max, b.Max) {
+		if !cfg.ignoreHistogramMinMax && !eqExtrema(a.Max, b.Max, cfg) {
 			reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
 		}
-		if a.Sum != b.Sum {
+		if !cfg.ignoreHistogramSum && !cfg.normalizeBucketCounts && !equalValues(a.Sum, b.Sum, cfg) {
 			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
 		}
 	}
-	if !cfg.ignoreExemplars {
-		r := compareDiff(diffSlices(
-			a.Exemplars,
-			b.Exemplars,
-			func(a, b metricdata.Exemplar[N]) bool {
-				r := equalExemplars(a, b, cfg)
-				return len(r) == 0
-			},
-		))
-		if r != "" {
-			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
+	reasons = append(reasons, equalExemplarSlices(a.Exemplars, b.Exemplars, cfg)...)
+	return reasons
+}
+
+// equalHistogramDistributions returns reasons the HistogramDataPoints a and b
+// do not describe the same distribution. If they do, the returned reasons
+// will be empty.
+//
+// The cumulative bucket counts are only compared at the bounds a and b share.
+func equalHistogramDistributions[N int64 | float64](a, b metricdata.HistogramDataPoint[N], cfg config) (reasons []string) {
+	if !cfg.normalizeBucketCounts && a.Count != b.Count {
+		reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
+	}
+	if !cfg.ignoreHistogramSum && !cfg.normalizeBucketCounts && !equalValues(a.Sum, b.Sum, cfg) {
+		reasons = append(reasons, notEqualValueStr("Sum", a.Sum, b.Sum))
+	}
+	if !cfg.ignoreHistogramMinMax && !eqExtrema(a.Min, b.Min, cfg) {
+		reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
+	}
+	if !cfg.ignoreHistogramMinMax && !eqExtrema(a.Max, b.Max, cfg) {
+		reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
+	}
+
+	for _, dp := range []metricdata.HistogramDataPoint[N]{a, b} {
+		if len(dp.BucketCounts) != len(dp.Bounds)+1 {
+			return append(reasons, fmt.Sprintf(
+				"invalid buckets: %d BucketCounts for %d Bounds",
+				len(dp.BucketCounts), len(dp.Bounds),
+			))
+		}
+	}
+
+	aCum, bCum := cumulativeCounts(a.BucketCounts), cumulativeCounts(b.BucketCounts)
+	for i, j := 0, 0; i < len(a.Bounds) && j < len(b.Bounds); {
+		switch {
+		case a.Bounds[i] < b.Bounds[j]:
+			i++
+		case a.Bounds[i] > b.Bounds[j]:
+			j++
+		default:
+			if !equalBucketCount(aCum[i], a.Count, bCum[j], b.Count, cfg) {
+				name := fmt.Sprintf("cumulative count at bound %v", a.Bounds[i])
+				reasons = append(reasons, notEqualStr(name, aCum[i], bCum[j]))
+			}
+			i++
+			j++
 		}
 	}
 	return reasons
 }
 
+// cumulativeCounts returns the running total of counts.
+func cumulativeCounts(counts []uint64) []uint64 {
+	cum := make([]uint64, len(counts))
+	var total uint64
+	for i, c := range counts {
+		total += c
+		cum[i] = total
+	}
+	return cum
+}
+
 // equalExponentialHistograms returns reasons exponential Histograms are not equal. If they are
 // equal, the returned reasons will be empty.
 //
 // The DataPoints each Histogram contains are compared based on containing the
 // same HistogramDataPoint, not the order they are stored in.
 func equalExponentialHistograms[N int64 | float64](a, b metricdata.ExponentialHistogram[N], cfg config) (reasons []string) {
-	if a.Temporality != b.Temporality {
+	if !cfg.ignoreTemporality && a.Temporality != b.Temporality {
 		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
 	}
 
-	r := compareDiff(diffSlices(
-		a.DataPoints,
-		b.DataPoints,
-		func(a, b metricdata.ExponentialHistogramDataPoint[N]) bool {
-			r := equalExponentialHistogramDataPoints(a, b, cfg)
-			return len(r) == 0
-		},
-	))
+	reasons = append(reasons, duplicateSeries(cfg, a.DataPoints, b.DataPoints, func(dp metricdata.ExponentialHistogramDataPoint[N]) attribute.Set {
+		return dp.Attributes
+	})...)
+
+	equal := func(a, b metricdata.ExponentialHistogramDataPoint[N]) bool {
+		r := equalExponentialHistogramDataPoints(a, b, cfg)
+		return len(r) == 0
+	}
+	extraA, extraB := diffDataPoints(cfg, a.DataPoints, b.DataPoints, equal)
+	r := compareDiff(cfg, extraA, extraB)
 	if r != "" {
-		reasons = append(reasons, fmt.Sprintf("Histogram DataPoints not equal:\n%s", r))
+		at := mismatchAt(cfg, a.DataPoints, b.DataPoints, equal)
+		reasons = append(reasons, fmt.Sprintf("Histogram DataPoints not equal%s:\n%s", at, r))
 	}
 	return reasons
 }
@@ -355,15 +678,9 @@ func equalExponentialHistograms[N int64 | float64](a, b metricdata.ExponentialHi
 // equalExponentialHistogramDataPoints returns reasons HistogramDataPoints are not equal.
 // If they are equal, the returned reasons will be empty.
 func equalExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.ExponentialHistogramDataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
-	if !a.Attributes.Equals(&b.Attributes) {
-		reasons = append(reasons, notEqualStr(
-			"Attributes",
-			a.Attributes.Encoded(attribute.DefaultEncoder()),
-			b.Attributes.Encoded(attribute.DefaultEncoder()),
-		))
-	}
+	reasons = append(reasons, equalAttributes(a.Attributes, b.Attributes, cfg)...)
 	if !cfg.ignoreTimestamp {
-		if !a.StartTime.Equal(b.StartTime) {
+		if !equalStartTimes(a.StartTime, b.StartTime, cfg) {
 			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
 		}
 		if !a.Time.Equal(b.Time) {
@@ -371,65 +688,202 @@ func equalExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.Expo
 		}
 	}
 	if !cfg.ignoreValue {
-		if a.Count != b.Count {
+		if !cfg.normalizeBucketCounts && a.Count != b.Count {
 			reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
 		}
-		if !eqExtrema(a.Min, b.Min) {
+		if !cfg.ignoreHistogramMinMax && !eqExtrema(a.Min, b.Min, cfg) {
 			reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
 		}
-		if !eqExtrema(a.Max, b.Max) {
+		if !cfg.ignoreHistogramMinMax && !eqExtrema(a.Max, b.Max, cfg) {
 			reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
 		}
-		if a.Sum != b.Sum {
+		if !cfg.ignoreHistogramSum && !cfg.normalizeBucketCounts && !equalValues(a.Sum, b.Sum, cfg) {
 			reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
 		}
 
 		if a.Scale != b.Scale {
 			reasons = append(reasons, notEqualStr("Scale", a.Scale, b.Scale))
 		}
-		if a.ZeroCount != b.ZeroCount {
+		if !equalBucketCount(a.ZeroCount, a.Count, b.ZeroCount, b.Count, cfg) {
 			reasons = append(reasons, notEqualStr("ZeroCount", a.ZeroCount, b.ZeroCount))
 		}
 
-		r := equalExponentialBuckets(a.PositiveBucket, b.PositiveBucket, cfg)
+		r := equalExponentialBucketsOf(a.PositiveBucket, a.Count, b.PositiveBucket, b.Count, cfg)
 		if len(r) > 0 {
+			reasons = append(reasons, "PositiveBucket not equal:")
 			reasons = append(reasons, r...)
 		}
-		r = equalExponentialBuckets(a.NegativeBucket, b.NegativeBucket, cfg)
+		r = equalExponentialBucketsOf(a.NegativeBucket, a.Count, b.NegativeBucket, b.Count, cfg)
 		if len(r) > 0 {
+			reasons = append(reasons, "NegativeBucket not equal:")
 			reasons = append(reasons, r...)
 		}
 	}
-	if !cfg.ignoreExemplars {
-		r := compareDiff(diffSlices(
-			a.Exemplars,
-			b.Exemplars,
-			func(a, b metricdata.Exemplar[N]) bool {
-				r := equalExemplars(a, b, cfg)
-				return len(r) == 0
-			},
-		))
-		if r != "" {
-			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
-		}
-	}
+	reasons = append(reasons, equalExemplarSlices(a.Exemplars, b.Exemplars, cfg)...)
 	return reasons
 }
 
-func equalExponentialBuckets(a, b metricdata.ExponentialBucket, _ config) (reasons []string) {
+// equalExponentialBuckets returns reasons ExponentialBuckets are not equal.
+// If they are equal, the returned reasons will be empty.
+//
+// If a and b have the same layout (offset and number of counts), each
+// differing count is reported individually. Otherwise, the full Counts of
+// both are reported along with their offsets.
+func equalExponentialBuckets(a, b metricdata.ExponentialBucket, cfg config) (reasons []string) {
+	return equalExponentialBucketsOf(a, sumCounts(a.Counts), b, sumCounts(b.Counts), cfg)
+}
+
+// equalExponentialBucketsOf is equalExponentialBuckets for the buckets a and
+// b of data points with a total count of aTotal and bTotal.
+func equalExponentialBucketsOf(a metricdata.ExponentialBucket, aTotal uint64, b metricdata.ExponentialBucket, bTotal uint64, cfg config) (reasons []string) {
+	if a.Offset == b.Offset && len(a.Counts) == len(b.Counts) {
+		for i := range a.Counts {
+			if !equalBucketCount(a.Counts[i], aTotal, b.Counts[i], bTotal, cfg) {
+				name := fmt.Sprintf("Counts[%d] (bucket index %d)", i, a.Offset+int32(i))
+				reasons = append(reasons, notEqualStr(name, a.Counts[i], b.Counts[i]))
+			}
+		}
+		return reasons
+	}
+
 	if a.Offset != b.Offset {
 		reasons = append(reasons, notEqualStr("Offset", a.Offset, b.Offset))
 	}
-	if !equalSlices(a.Counts, b.Counts) {
-		reasons = append(reasons, notEqualStr("Counts", a.Counts, b.Counts))
+	if !equalBucketCounts(a.Counts, aTotal, b.Counts, bTotal, cfg) {
+		reasons = append(reasons, notEqualStr(
+			"Counts",
+			fmt.Sprintf("%v (offset %d)", a.Counts, a.Offset),
+			fmt.Sprintf("%v (offset %d)", b.Counts, b.Offset),
+		))
 	}
 	return reasons
 }
 
+// equalStartTimes returns if the data point start times a and b are equal
+// based on cfg.
+func equalStartTimes(a, b time.Time, cfg config) bool {
+	if cfg.ignoreZeroStartTime && (a.IsZero() || b.IsZero()) {
+		return true
+	}
+	return a.Equal(b)
+}
+
+// equalAttributes returns reasons the data point attributes a and b are not
+// equal. If they are equal, the returned reasons will be empty.
+//
+// If cfg has an attribute filter, only the attributes that pass the filter
+// are compared.
+func equalAttributes(a, b attribute.Set, cfg config) (reasons []string) {
+	if cfg.attributeFilter != nil {
+		a, _ = a.Filter(cfg.attributeFilter)
+		b, _ = b.Filter(cfg.attributeFilter)
+	}
+	if !equalSets(a, b, cfg) {
+		reasons = append(reasons, attributesDiffStr(a, b, cfg))
+	}
+	return reasons
+}
+
+// attributesDiffStr returns a summary of the differences between the
+// attribute sets a and b: the attributes only in a, the attributes only in
+// b, and the keys in both with values that are not equal.
+func attributesDiffStr(a, b attribute.Set, cfg config) string {
+	var onlyA, onlyB []attribute.KeyValue
+	var differ []string
+
+	// Both sets are sorted by key.
+	i, j := 0, 0
+	for i < a.Len() || j < b.Len() {
+		aKV, aOk := a.Get(i)
+		bKV, bOk := b.Get(j)
+		switch {
+		case !bOk || (aOk && aKV.Key < bKV.Key):
+			onlyA = append(onlyA, aKV)
+			i++
+		case !aOk || bKV.Key < aKV.Key:
+			onlyB = append(onlyB, bKV)
+			j++
+		default:
+			if !equalKeyValue(aKV, bKV, cfg) {
+				differ = append(differ, fmt.Sprintf(
+					"%s: expected %s, actual %s",
+					aKV.Key, aKV.Value.Emit(), bKV.Value.Emit(),
+				))
+			}
+			i++
+			j++
+		}
+	}
+
+	return fmt.Sprintf(
+		"Attributes not equal:\nonly in expected: %s\nonly in actual: %s\ndifferent values: {%s}",
+		fmtSortedKeyValues(onlyA),
+		fmtSortedKeyValues(onlyB),
+		strings.Join(differ, ", "),
+	)
+}
+
+// equalSets returns if the attribute sets a and b are equal based on cfg.
+//
+// If cfg has a tolerance, FLOAT64 and FLOAT64SLICE attribute values are
+// compared using it. All other attribute values are compared exactly.
+func equalSets(a, b attribute.Set, cfg config) bool {
+	if a.Equals(&b) {
+		return true
+	}
+	if (cfg.tolerance <= 0 && cfg.relativeTolerance <= 0) || a.Len() != b.Len() {
+		return false
+	}
+	// Both sets are sorted by key.
+	for i := 0; i < a.Len(); i++ {
+		aKV, _ := a.Get(i)
+		bKV, _ := b.Get(i)
+		if !equalKeyValue(aKV, bKV, cfg) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalKeyValue returns if the attributes a and b are equal based on cfg.
+// Only the tolerances of cfg are applied to float values, a value comparer
+// is not used.
+func equalKeyValue(a, b attribute.KeyValue, cfg config) bool {
+	if a.Key != b.Key || a.Value.Type() != b.Value.Type() {
+		return false
+	}
+	cfg.valueComparer = nil
+	switch a.Value.Type() {
+	case attribute.FLOAT64:
+		return equalValues(a.Value.AsFloat64(), b.Value.AsFloat64(), cfg)
+	case attribute.FLOAT64SLICE:
+		aS, bS := a.Value.AsFloat64Slice(), b.Value.AsFloat64Slice()
+		if len(aS) != len(bS) {
+			return false
+		}
+		for i := range aS {
+			if !equalValues(aS[i], bS[i], cfg) {
+				return false
+			}
+		}
+		return true
+	}
+	// All other types, including unknown ones, are compared by equality so
+	// an unexpected type fails the comparison instead of panicking.
+	return a.Value == b.Value
+}
+
 func notEqualStr(prefix string, expected, actual interface{}) string {
 	return fmt.Sprintf("%s not equal:\nexpected: %v\nactual: %v", prefix, expected, actual)
 }
 
+// notEqualValueStr is the same as notEqualStr, but also reports the
+// difference between the numeric values expected and actual. This makes
+// values recorded with the wrong scale or unit easy to identify.
+func notEqualValueStr[N int64 | float64](prefix string, expected, actual N) string {
+	return fmt.Sprintf("%s (diff %v)", notEqualStr(prefix, expected, actual), actual-expected)
+}
+
 func equalSlices[T comparable](a, b []T) bool {
 	if len(a) != len(b) {
 		return false
@@ -442,81 +896,123 @@ func equalSlices[T comparable](a, b []T) bool {
 	return true
 }
 
-func equalExtrema[N int64 | float64](a, b metricdata.Extrema[N], _ config) (reasons []string) {
-	if !eqExtrema(a, b) {
+// equalBucketCounts returns if the bucket counts a and b are equal based on
+// cfg. The aTotal and bTotal are the total counts of the data points a and
+// b are from.
+func equalBucketCounts(a []uint64, aTotal uint64, b []uint64, bTotal uint64, cfg config) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if !equalBucketCount(v, aTotal, b[i], bTotal, cfg) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalBucketCount returns if the bucket count a and b are equal based on
+// cfg. If cfg normalizes bucket counts, a and b are compared as fractions of
+// aTotal and bTotal.
+func equalBucketCount(a, aTotal, b, bTotal uint64, cfg config) bool {
+	if cfg.normalizeBucketCounts {
+		return equalValues(bucketFraction(a, aTotal), bucketFraction(b, bTotal), cfg)
+	}
+	if a > b {
+		return a-b <= cfg.bucketCountTolerance
+	}
+	return b-a <= cfg.bucketCountTolerance
+}
+
+// bucketFraction returns the fraction of total the count is. It returns 0 if
+// total is 0.
+func bucketFraction(count, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
+
+func sumCounts(counts []uint64) (total uint64) {
+	for _, c := range counts {
+		total += c
+	}
+	return total
+}
+
+// trimTrailingZeroBuckets returns bounds and counts with the trailing buckets
+// that have a count of zero removed, along with the bounds that start them.
+// The first bucket is never removed. If counts does not contain exactly one
+// more bucket than bounds, bounds and counts are returned unchanged.
+func trimTrailingZeroBuckets(bounds []float64, counts []uint64) ([]float64, []uint64) {
+	if len(counts) != len(bounds)+1 {
+		return bounds, counts
+	}
+	n := len(counts)
+	for n > 1 && counts[n-1] == 0 {
+		n--
+	}
+	return bounds[:n-1], counts[:n]
+}
+
+func equalExtrema[N int64 | float64](a, b metricdata.Extrema[N], cfg config) (reasons []string) {
+	if !eqExtrema(a, b, cfg) {
 		reasons = append(reasons, notEqualStr("Extrema", a, b))
 	}
 	return reasons
 }
 
-func eqExtrema[N int64 | float64](a, b metricdata.Extrema[N]) bool {
+func eqExtrema[N int64 | float64](a, b metricdata.Extrema[N], cfg config) bool {
 	aV, aOk := a.Value()
 	bV, bOk := b.Value()
 
 	if !aOk || !bOk {
 		return aOk == bOk
 	}
-	return aV == bV
+	return equalValues(aV, bV, cfg)
 }
 
-func equalKeyValue(a, b []attribute.KeyValue) bool {
-	// Comparison of []attribute.KeyValue as a comparable requires Go >= 1.20.
-	// To support Go < 1.20 use this function instead.
-	if len(a) != len(b) {
-		return false
+// equalDataPointValues returns if the DataPoint values a and b are equal
+// based on cfg. If cfg treats zero as unset, a zero value is equal to any
+// other value.
+func equalDataPointValues[N int64 | float64](a, b N, cfg config) bool {
+	if cfg.zeroAsUnset && (a == 0 || b == 0) {
+		return true
 	}
-	for i, v := range a {
-		if v.Key != b[i].Key {
-			return false
-		}
-		if v.Value.Type() != b[i].Value.Type() {
-			return false
-		}
-		switch v.Value.Type() {
-		case attribute.BOOL:
-			if v.Value.AsBool() != b[i].Value.AsBool() {
-				return false
-			}
-		case attribute.INT64:
-			if v.Value.AsInt64() != b[i].Value.AsInt64() {
-				return false
-			}
-		case attribute.FLOAT64:
-			if v.Value.AsFloat64() != b[i].Value.AsFloat64() {
-				return false
-			}
-		case attribute.STRING:
-			if v.Value.AsString() != b[i].Value.AsString() {
-				return false
-			}
-		case attribute.BOOLSLICE:
-			if ok := equalSlices(v.Value.AsBoolSlice(), b[i].Value.AsBoolSlice()); !ok {
-				return false
-			}
-		case attribute.INT64SLICE:
-			if ok := equalSlices(v.Value.AsInt64Slice(), b[i].Value.AsInt64Slice()); !ok {
-				return false
-			}
-		case attribute.FLOAT64SLICE:
-			if ok := equalSlices(v.Value.AsFloat64Slice(), b[i].Value.AsFloat64Slice()); !ok {
-				return false
-			}
-		case attribute.STRINGSLICE:
-			if ok := equalSlices(v.Value.AsStringSlice(), b[i].Value.AsStringSlice()); !ok {
-				return false
-			}
-		default:
-			// We control all types passed to this, panic to signal developers
-			// early they changed things in an incompatible way.
-			panic(fmt.Sprintf("unknown attribute value type: %s", v.Value.Type()))
-		}
+	return equalValues(a, b, cfg)
+}
+
+// equalValues returns if the numeric values a and b are equal based on cfg.
+func equalValues[N int64 | float64](a, b N, cfg config) bool {
+	if cfg.valueComparer != nil {
+		return cfg.valueComparer(float64(a), float64(b))
 	}
-	return true
+	if a == b {
+		return true
+	}
+	if math.IsNaN(float64(a)) && math.IsNaN(float64(b)) {
+		// Keep the comparison of a value with itself equal.
+		return true
+	}
+
+	diff := math.Abs(float64(a) - float64(b))
+	if cfg.tolerance > 0 && diff <= cfg.tolerance {
+		return true
+	}
+	if cfg.relativeTolerance > 0 && a != 0 && b != 0 {
+		magnitude := math.Max(math.Abs(float64(a)), math.Abs(float64(b)))
+		return diff <= cfg.relativeTolerance*magnitude
+	}
+	return false
 }
 
 func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config) (reasons []string) {
-	if !equalKeyValue(a.FilteredAttributes, b.FilteredAttributes) {
-		reasons = append(reasons, notEqualStr("FilteredAttributes", a.FilteredAttributes, b.FilteredAttributes))
+	if !cfg.ignoreExemplarFilteredAttributes {
+		// Compare as sets so the order the attributes are stored in does not
+		// matter, the same as DataPoint Attributes.
+		if !eqKeyValues(a.FilteredAttributes, b.FilteredAttributes, cfg) {
+			reasons = append(reasons, notEqualStr("FilteredAttributes", fmtKeyValues(a.FilteredAttributes), fmtKeyValues(b.FilteredAttributes)))
+		}
 	}
 	if !cfg.ignoreTimestamp {
 		if !a.Time.Equal(b.Time) {
@@ -524,10 +1020,19 @@ func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config)
 		}
 	}
 	if !cfg.ignoreValue {
-		if a.Value != b.Value {
-			reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
+		if !equalValues(a.Value, b.Value, cfg) {
+			reasons = append(reasons, notEqualValueStr("Value", a.Value, b.Value))
 		}
 	}
+	if cfg.requireNonZeroTraceContext {
+		if isZeroID(b.SpanID) {
+			reasons = append(reasons, zeroIDStr("SpanID", b.SpanID))
+		}
+		if isZeroID(b.TraceID) {
+			reasons = append(reasons, zeroIDStr("TraceID", b.TraceID))
+		}
+		return reasons
+	}
 	if !equalSlices(a.SpanID, b.SpanID) {
 		reasons = append(reasons, notEqualStr("SpanID", a.SpanID, b.SpanID))
 	}
@@ -537,62 +1042,518 @@ func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config)
 	return reasons
 }
 
-func diffSlices[T any](a, b []T, equal func(T, T) bool) (extraA, extraB []T) {
+// isZeroID returns if the span or trace ID id is empty or only contains
+// zeros.
+func isZeroID(id []byte) bool {
+	for _, b := range id {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func zeroIDStr(name string, id []byte) string {
+	return fmt.Sprintf("%s not set:\nexpected: non-zero\nactual: %v", name, id)
+}
+
+// equalExemplarSlices returns reasons the Exemplars of two data points are
+// not equal. If they are equal, or cfg ignores Exemplars, the returned
+// reasons will be empty.
+//
+// If cfg is configured for an Exemplar subset comparison, b is allowed to
+// contain additional Exemplars.
+func equalExemplarSlices[N int64 | float64](a, b []metricdata.Exemplar[N], cfg config) (reasons []string) {
+	if cfg.ignoreExemplars {
+		return nil
+	}
+	exCfg := cfg
+	exCfg.subset = cfg.subset || cfg.exemplarSubset
+	extraA, extraB := diffSlices(
+		exCfg,
+		a,
+		b,
+		func(a, b metricdata.Exemplar[N]) bool {
+			r := equalExemplars(a, b, cfg)
+			return len(r) == 0
+		},
+	)
+	r := compareDiff(cfg, extraA, extraB)
+	if r != "" {
+		reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
+	}
+	return reasons
+}
+
+// diffSlices returns the elements of a that are not matched by an element of
+// b, and the elements of b that are not matched by an element of a. Elements
+// are matched using equal, and each element is matched at most once.
+//
+// Elements are matched the same as pairElement, so the number of unmatched
+// elements does not depend on the order of a and b, and is the same when a
+// and b are swapped, even if equal is not transitive.
+//
+// If cfg is configured for a subset comparison, b is allowed to contain
+// additional elements and only the unmatched elements of a are returned.
+func diffSlices[T any](cfg config, a, b []T, equal func(T, T) bool) (extraA, extraB []T) {
+	if cfg.scratch == nil {
+		return diffSlicesWith(cfg, a, b, equal, make([]int, len(b)), make([]bool, len(b)))
+	}
+	pairs, seen := cfg.scratch.get(len(b))
+	defer cfg.scratch.put(pairs, seen)
+	return diffSlicesWith(cfg, a, b, equal, pairs, seen)
+}
+
+// diffSlicesWith is diffSlices using the pairs and seen buffers, which need
+// to have the same length as b.
+func diffSlicesWith[T any](cfg config, a, b []T, equal func(T, T) bool, pairs []int, seen []bool) (extraA, extraB []T) {
+	for j := range pairs {
+		pairs[j] = -1
+	}
+	for i := range a {
+		if !pairElement(a, b, i, equal, pairs, seen) {
+			extraA = append(extraA, a[i])
+		}
+	}
+
+	if cfg.subset {
+		return extraA, nil
+	}
+	for j := range b {
+		if pairs[j] < 0 {
+			extraB = append(extraB, b[j])
+		}
+	}
+	return extraA, extraB
+}
+
+// pairSlices returns the reasons the elements of a and b that are paired by
+// key are not equal, and the elements of a and b that are not paired. Each
+// element of a is paired with the first unpaired element of b with the same
+// key.
+//
+// If cfg is configured for a subset comparison, unpaired elements of b are
+// not returned.
+func pairSlices[T any](cfg config, a, b []T, key func(T) string, equal func(T, T) []string) (reasons []string, extraA, extraB []T) {
 	visited := make([]bool, len(b))
-	for i := 0; i < len(a); i++ {
+	for i := range a {
+		k := key(a[i])
 		found := false
-		for j := 0; j < len(b); j++ {
-			if visited[j] {
+		for j := range b {
+			if visited[j] || key(b[j]) != k {
 				continue
 			}
-			if equal(a[i], b[j]) {
-				visited[j] = true
-				found = true
-				break
-			}
+			visited[j] = true
+			found = true
+			reasons = append(reasons, equal(a[i], b[j])...)
+			break
 		}
 		if !found {
 			extraA = append(extraA, a[i])
 		}
 	}
 
-	for j := 0; j < len(b); j++ {
-		if visited[j] {
-			continue
+	if cfg.subset {
+		return reasons, extraA, nil
+	}
+	for j := range b {
+		if !visited[j] {
+			extraB = append(extraB, b[j])
 		}
-		extraB = append(extraB, b[j])
 	}
+	return reasons, extraA, extraB
+}
+
+// mismatchAt returns the position of the first data points of a and b that
+// are not equal, formatted to be appended to a reason. It returns an empty
+// string if data points are not compared positionally.
+func mismatchAt[T any](cfg config, a, b []T, equal func(T, T) bool) string {
+	if !cfg.orderedDataPoints {
+		return ""
+	}
+	i := 0
+	for ; i < len(a) && i < len(b); i++ {
+		if !equal(a[i], b[i]) {
+			break
+		}
+	}
+	return fmt.Sprintf(" at index %d", i)
+}
+
+// diffDataPoints returns the data points of a and b that are not matched by
+// each other. Data points are matched positionally if cfg is configured for
+// an ordered comparison, otherwise they are matched the same as diffSlices.
+func diffDataPoints[T any](cfg config, a, b []T, equal func(T, T) bool) (extraA, extraB []T) {
+	if !cfg.orderedDataPoints {
+		return diffSlices(cfg, a, b, equal)
+	}
+
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		if !equal(a[i], b[i]) {
+			extraA = append(extraA, a[i])
+			extraB = append(extraB, b[i])
+		}
+	}
+	extraA = append(extraA, a[n:]...)
+	extraB = append(extraB, b[n:]...)
 
+	if cfg.subset {
+		extraB = nil
+	}
 	return extraA, extraB
 }
 
-func compareDiff[T any](extraExpected, extraActual []T) string {
-	if len(extraExpected) == 0 && len(extraActual) == 0 {
-		return ""
+// duplicateSeries returns reasons a or b contain more than one data point
+// with equal attributes, if cfg disallows duplicate series. The attributes of
+// a data point are returned by attrs.
+func duplicateSeries[T any](cfg config, a, b []T, attrs func(T) attribute.Set) (reasons []string) {
+	if !cfg.disallowDuplicateSeries {
+		return nil
+	}
+	for _, set := range findDuplicateSeries(a, attrs) {
+		reasons = append(reasons, fmt.Sprintf("expected DataPoints contain duplicate series: %s", fmtSet(set)))
 	}
+	for _, set := range findDuplicateSeries(b, attrs) {
+		reasons = append(reasons, fmt.Sprintf("actual DataPoints contain duplicate series: %s", fmtSet(set)))
+	}
+	return reasons
+}
 
-	formatter := func(v T) string {
-		return fmt.Sprintf("%#v", v)
+// findDuplicateSeries returns the attribute sets shared by more than one
+// element of dps, in the order they are first duplicated.
+func findDuplicateSeries[T any](dps []T, attrs func(T) attribute.Set) []attribute.Set {
+	var dups []attribute.Set
+	seen := make(map[attribute.Distinct]int, len(dps))
+	for _, dp := range dps {
+		set := attrs(dp)
+		key := set.Equivalent()
+		seen[key]++
+		if seen[key] == 2 {
+			dups = append(dups, set)
+		}
+	}
+	return dups
+}
+
+// compareDiff returns a rendering of the unmatched expected and actual
+// values formatted based on cfg. The values are sorted so the same mismatch
+// is always rendered identically, independent of the order the values were
+// stored in.
+func compareDiff[T any](cfg config, extraExpected, extraActual []T) string {
+	if len(extraExpected) == 0 && len(extraActual) == 0 {
+		return ""
 	}
 
 	var msg bytes.Buffer
 	if len(extraExpected) > 0 {
 		_, _ = msg.WriteString("missing expected values:\n")
-		for _, v := range extraExpected {
-			_, _ = msg.WriteString(formatter(v) + "\n")
+		for _, v := range sortedDumps(cfg, extraExpected) {
+			_, _ = msg.WriteString(v + "\n")
 		}
 	}
 
 	if len(extraActual) > 0 {
 		_, _ = msg.WriteString("unexpected additional values:\n")
-		for _, v := range extraActual {
-			_, _ = msg.WriteString(formatter(v) + "\n")
+		for _, v := range sortedDumps(cfg, extraActual) {
+			_, _ = msg.WriteString(v + "\n")
 		}
 	}
 
 	return msg.String()
 }
 
+// sortedDumps returns the Dump of each value in vals, sorted by the sort key
+// of the value and then by the Dump itself.
+func sortedDumps[T any](cfg config, vals []T) []string {
+	type entry struct {
+		key, dump string
+	}
+	entries := make([]entry, len(vals))
+	for i, v := range vals {
+		entries[i] = entry{key: diffSortKey(v), dump: cfg.format(v)}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].key != entries[j].key {
+			return entries[i].key < entries[j].key
+		}
+		return entries[i].dump < entries[j].dump
+	})
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = e.dump
+	}
+	return out
+}
+
+// diffSortKey returns the primary key v is sorted by when rendered in a
+// diff. Data points are sorted by their encoded attributes, Metrics by name,
+// and ScopeMetrics by scope name. All other values return an empty key and
+// are only sorted by their rendering.
+func diffSortKey(v any) string {
+	enc := attribute.DefaultEncoder()
+	switch v := v.(type) {
+	case metricdata.DataPoint[int64]:
+		return v.Attributes.Encoded(enc)
+	case metricdata.DataPoint[float64]:
+		return v.Attributes.Encoded(enc)
+	case metricdata.HistogramDataPoint[int64]:
+		return v.Attributes.Encoded(enc)
+	case metricdata.HistogramDataPoint[float64]:
+		return v.Attributes.Encoded(enc)
+	case metricdata.ExponentialHistogramDataPoint[int64]:
+		return v.Attributes.Encoded(enc)
+	case metricdata.ExponentialHistogramDataPoint[float64]:
+		return v.Attributes.Encoded(enc)
+	case metricdata.Metrics:
+		return v.Name
+	case metricdata.ScopeMetrics:
+		return v.Scope.Name
+	}
+	return ""
+}
+
+// monotonicIncreasing returns reasons curr is not a valid successor of prev
+// for a cumulative monotonic Sum. If it is, the returned reasons will be
+// empty.
+func monotonicIncreasing[N int64 | float64](prev, curr metricdata.Sum[N]) (reasons []string) {
+	for _, s := range []struct {
+		name string
+		sum  metricdata.Sum[N]
+	}{{"previous", prev}, {"current", curr}} {
+		if !s.sum.IsMonotonic {
+			reasons = append(reasons, fmt.Sprintf("%s Sum is not monotonic", s.name))
+		}
+		if s.sum.Temporality != metricdata.CumulativeTemporality {
+			reasons = append(reasons, fmt.Sprintf("%s Sum Temporality is not cumulative: %s", s.name, s.sum.Temporality))
+		}
+	}
+
+	for _, c := range curr.DataPoints {
+		for _, p := range prev.DataPoints {
+			if !c.Attributes.Equals(&p.Attributes) {
+				continue
+			}
+			if c.Value < p.Value {
+				reasons = append(reasons, fmt.Sprintf(
+					"Sum DataPoint %s decreased:\nprevious: %v\ncurrent: %v",
+					fmtSet(c.Attributes), p.Value, c.Value,
+				))
+			}
+			break
+		}
+	}
+	return reasons
+}
+
+// dataPointValue returns reasons agg does not contain exactly one data point
+// with attributes attrs and value want. If it does, the returned reasons will
+// be empty.
+func dataPointValue[N int64 | float64](agg metricdata.Aggregation, attrs attribute.Set, want N, cfg config) (reasons []string) {
+	var dPts []metricdata.DataPoint[N]
+	switch a := agg.(type) {
+	case metricdata.Gauge[N]:
+		dPts = a.DataPoints
+	case metricdata.Sum[N]:
+		dPts = a.DataPoints
+	default:
+		return []string{fmt.Sprintf("unsupported aggregation %T: expected Gauge[%[2]T] or Sum[%[2]T]", agg, want)}
+	}
+
+	var found []metricdata.DataPoint[N]
+	for _, dp := range dPts {
+		if dp.Attributes.Equals(&attrs) {
+			found = append(found, dp)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return []string{fmt.Sprintf("no data point with attributes %s", fmtSet(attrs))}
+	case 1:
+	default:
+		return []string{fmt.Sprintf("%d data points with attributes %s", len(found), fmtSet(attrs))}
+	}
+
+	if !equalValues(want, found[0].Value, cfg) {
+		reasons = append(reasons, notEqualValueStr(
+			fmt.Sprintf("DataPoint %s Value", fmtSet(attrs)),
+			want,
+			found[0].Value,
+		))
+	}
+	return reasons
+}
+
+// dataPointValues returns reasons the data points dPts do not correspond
+// exactly to want. Each entry of want needs to have one data point with equal
+// attributes and value, and there cannot be any other data points. If they
+// correspond, the returned reasons will be empty.
+func dataPointValues[N int64 | float64](dPts []metricdata.DataPoint[N], want map[attribute.Set]N, cfg config) (reasons []string) {
+	seen := make(map[attribute.Distinct]bool, len(dPts))
+	for _, dp := range dPts {
+		key := dp.Attributes.Equivalent()
+		if seen[key] {
+			reasons = append(reasons, fmt.Sprintf("duplicate data point with attributes %s", fmtSet(dp.Attributes)))
+			continue
+		}
+		seen[key] = true
+
+		w, ok := want[dp.Attributes]
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("unexpected data point with attributes %s: %v", fmtSet(dp.Attributes), dp.Value))
+			continue
+		}
+		if !equalValues(w, dp.Value, cfg) {
+			reasons = append(reasons, notEqualValueStr(
+				fmt.Sprintf("DataPoint %s Value", fmtSet(dp.Attributes)),
+				w,
+				dp.Value,
+			))
+		}
+	}
+
+	var missing []string
+	for attrs := range want {
+		if !seen[attrs.Equivalent()] {
+			missing = append(missing, fmt.Sprintf("no data point with attributes %s", fmtSet(attrs)))
+		}
+	}
+	// Map iteration order is random, keep the reasons stable.
+	sort.Strings(missing)
+	return append(reasons, missing...)
+}
+
+// dataPointsLen returns the number of data points agg contains. If agg is not
+// a known aggregation, the returned reasons will not be empty.
+func dataPointsLen(agg metricdata.Aggregation) (n int, reasons []string) {
+	switch agg := agg.(type) {
+	case metricdata.Gauge[int64]:
+		n = len(agg.DataPoints)
+	case metricdata.Gauge[float64]:
+		n = len(agg.DataPoints)
+	case metricdata.Sum[int64]:
+		n = len(agg.DataPoints)
+	case metricdata.Sum[float64]:
+		n = len(agg.DataPoints)
+	case metricdata.Histogram[int64]:
+		n = len(agg.DataPoints)
+	case metricdata.Histogram[float64]:
+		n = len(agg.DataPoints)
+	case metricdata.Summary[int64]:
+		n = len(agg.DataPoints)
+	case metricdata.Summary[float64]:
+		n = len(agg.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		n = len(agg.DataPoints)
+	case metricdata.ExponentialHistogram[float64]:
+		n = len(agg.DataPoints)
+	default:
+		reasons = []string{fmt.Sprintf("unknown aggregation %T", agg)}
+	}
+	return n, reasons
+}
+
+// exemplarCount returns reasons the data point of kind with attrs does not
+// have want Exemplars, where got is the number of Exemplars it has.
+func exemplarCount(kind string, attrs attribute.Set, got, want int) (reasons []string) {
+	if got != want {
+		reasons = append(reasons, notEqualStr(
+			fmt.Sprintf("%s %s Exemplar count", kind, fmtSet(attrs)),
+			want,
+			got,
+		))
+	}
+	return reasons
+}
+
+// hasExemplar returns reasons exemplars does not contain an Exemplar equal
+// to want. If it does, the returned reasons will be empty.
+func hasExemplar[N int64 | float64](exemplars []metricdata.Exemplar[N], want metricdata.Exemplar[N], cfg config) (reasons []string) {
+	for _, e := range exemplars {
+		if len(equalExemplars(want, e, cfg)) == 0 {
+			return nil
+		}
+	}
+	reason := fmt.Sprintf("Exemplar not found:\nexpected:\n%s\nactual:", cfg.format(want))
+	for _, e := range exemplars {
+		reason += "\n" + cfg.format(e)
+	}
+	return []string{reason}
+}
+
+// exemplarsLinkTrace returns reasons exemplars does not contain an Exemplar
+// with traceID and spanID. If it does, the returned reasons will be empty.
+func exemplarsLinkTrace[N int64 | float64](exemplars []metricdata.Exemplar[N], traceID trace.TraceID, spanID trace.SpanID) (reasons []string) {
+	for _, e := range exemplars {
+		if equalSlices(e.TraceID, traceID[:]) && equalSlices(e.SpanID, spanID[:]) {
+			return nil
+		}
+	}
+	reason := fmt.Sprintf("no Exemplar with TraceID %s and SpanID %s, have:", traceID, spanID)
+	for _, e := range exemplars {
+		reason += fmt.Sprintf("\n\tTraceID %x SpanID %x", e.TraceID, e.SpanID)
+	}
+	return []string{reason}
+}
+
+// Valid scale range of exponential histograms defined by the OpenTelemetry
+// specification.
+const (
+	minExponentialScale = -10
+	maxExponentialScale = 20
+)
+
+// validExponentialHistogram returns reasons dp is not a structurally valid
+// ExponentialHistogramDataPoint. If it is valid, the returned reasons will be
+// empty.
+func validExponentialHistogram[N int64 | float64](dp metricdata.ExponentialHistogramDataPoint[N]) (reasons []string) {
+	prefix := fmt.Sprintf("ExponentialHistogramDataPoint %s", fmtSet(dp.Attributes))
+
+	total := dp.ZeroCount
+	for _, b := range []metricdata.ExponentialBucket{dp.PositiveBucket, dp.NegativeBucket} {
+		for _, c := range b.Counts {
+			total += c
+		}
+	}
+	if total != dp.Count {
+		reasons = append(reasons, fmt.Sprintf(
+			"%s Count not equal to ZeroCount and bucket counts:\nCount: %d\ntotal: %d",
+			prefix, dp.Count, total,
+		))
+	}
+
+	if dp.Scale < minExponentialScale || dp.Scale > maxExponentialScale {
+		reasons = append(reasons, fmt.Sprintf(
+			"%s Scale %d not in valid range [%d, %d]",
+			prefix, dp.Scale, minExponentialScale, maxExponentialScale,
+		))
+	}
+
+	for _, b := range []struct {
+		name   string
+		bucket metricdata.ExponentialBucket
+	}{{"PositiveBucket", dp.PositiveBucket}, {"NegativeBucket", dp.NegativeBucket}} {
+		// The index of the last bucket needs to be a valid int32.
+		last := int64(b.bucket.Offset) + int64(len(b.bucket.Counts)) - 1
+		if last > math.MaxInt32 {
+			reasons = append(reasons, fmt.Sprintf(
+				"%s %s Offset %d with %d Counts overflows int32",
+				prefix, b.name, b.bucket.Offset, len(b.bucket.Counts),
+			))
+		}
+	}
+
+	minV, minOk := dp.Min.Value()
+	maxV, maxOk := dp.Max.Value()
+	if minOk && maxOk && minV > maxV {
+		reasons = append(reasons, fmt.Sprintf("%s Min %v greater than Max %v", prefix, minV, maxV))
+	}
+	return reasons
+}
+
 func missingAttrStr(name string) string {
 	return fmt.Sprintf("missing attribute %s", name)
 }
@@ -673,6 +1634,31 @@ func hasAttributesHistogram[T int64 | float64](histogram metricdata.Histogram[T]
 	return reasons
 }
 
+func hasAttributesSummaryDataPoints[T int64 | float64](dp metricdata.SummaryDataPoint[T], attrs ...attribute.KeyValue) (reasons []string) {
+	for _, attr := range attrs {
+		val, ok := dp.Attributes.Value(attr.Key)
+		if !ok {
+			reasons = append(reasons, missingAttrStr(string(attr.Key)))
+			continue
+		}
+		if val != attr.Value {
+			reasons = append(reasons, notEqualStr(string(attr.Key), attr.Value.Emit(), val.Emit()))
+		}
+	}
+	return reasons
+}
+
+func hasAttributesSummary[T int64 | float64](summary metricdata.Summary[T], attrs ...attribute.KeyValue) (reasons []string) {
+	for n, dp := range summary.DataPoints {
+		reas := hasAttributesSummaryDataPoints(dp, attrs...)
+		if len(reas) > 0 {
+			reasons = append(reasons, fmt.Sprintf("summary datapoint %d attributes:\n", n))
+			reasons = append(reasons, reas...)
+		}
+	}
+	return reasons
+}
+
 func hasAttributesExponentialHistogramDataPoints[T int64 | float64](dp metricdata.ExponentialHistogramDataPoint[T], attrs ...attribute.KeyValue) (reasons []string) {
 	for _, attr := range attrs {
 		val, ok := dp.Attributes.Value(attr.Key)
@@ -712,6 +1698,10 @@ func hasAttributesAggregation(agg metricdata.Aggregation, attrs ...attribute.Key
 		reasons = hasAttributesHistogram(agg, attrs...)
 	case metricdata.Histogram[float64]:
 		reasons = hasAttributesHistogram(agg, attrs...)
+	case metricdata.Summary[int64]:
+		reasons = hasAttributesSummary(agg, attrs...)
+	case metricdata.Summary[float64]:
+		reasons = hasAttributesSummary(agg, attrs...)
 	case metricdata.ExponentialHistogram[int64]:
 		reasons = hasAttributesExponentialHistogram(agg, attrs...)
 	case metricdata.ExponentialHistogram[float64]:
@@ -752,3 +1742,94 @@ func hasAttributesResourceMetrics(rm metricdata.ResourceMetrics, attrs ...attrib
 	}
 	return reasons
 }
+
+// timestampWithin returns reasons the Time, or the StartTime if it is not
+// zero, of the data point with attrs are not within [start, end].
+func timestampWithin(attrs attribute.Set, startTime, ts, start, end time.Time) (reasons []string) {
+	for _, f := range []struct {
+		name string
+		t    time.Time
+	}{{"StartTime", startTime}, {"Time", ts}} {
+		if f.name == "StartTime" && f.t.IsZero() {
+			continue
+		}
+		if f.t.Before(start) || f.t.After(end) {
+			reasons = append(reasons, fmt.Sprintf(
+				"DataPoint %s %s %s not within [%s, %s]",
+				fmtSet(attrs), f.name,
+				f.t.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano), end.Format(time.RFC3339Nano),
+			))
+		}
+	}
+	return reasons
+}
+
+func timestampsWithinDataPoints[N int64 | float64](dPts []metricdata.DataPoint[N], start, end time.Time) (reasons []string) {
+	for _, dp := range dPts {
+		reasons = append(reasons, timestampWithin(dp.Attributes, dp.StartTime, dp.Time, start, end)...)
+	}
+	return reasons
+}
+
+func timestampsWithinHistogramDataPoints[N int64 | float64](dPts []metricdata.HistogramDataPoint[N], start, end time.Time) (reasons []string) {
+	for _, dp := range dPts {
+		reasons = append(reasons, timestampWithin(dp.Attributes, dp.StartTime, dp.Time, start, end)...)
+	}
+	return reasons
+}
+
+func timestampsWithinSummaryDataPoints[N int64 | float64](dPts []metricdata.SummaryDataPoint[N], start, end time.Time) (reasons []string) {
+	for _, dp := range dPts {
+		reasons = append(reasons, timestampWithin(dp.Attributes, dp.StartTime, dp.Time, start, end)...)
+	}
+	return reasons
+}
+
+func timestampsWithinExponentialHistogramDataPoints[N int64 | float64](dPts []metricdata.ExponentialHistogramDataPoint[N], start, end time.Time) (reasons []string) {
+	for _, dp := range dPts {
+		reasons = append(reasons, timestampWithin(dp.Attributes, dp.StartTime, dp.Time, start, end)...)
+	}
+	return reasons
+}
+
+func timestampsWithinAggregation(agg metricdata.Aggregation, start, end time.Time) (reasons []string) {
+	switch agg := agg.(type) {
+	case nil:
+	case metricdata.Gauge[int64]:
+		reasons = timestampsWithinDataPoints(agg.DataPoints, start, end)
+	case metricdata.Gauge[float64]:
+		reasons = timestampsWithinDataPoints(agg.DataPoints, start, end)
+	case metricdata.Sum[int64]:
+		reasons = timestampsWithinDataPoints(agg.DataPoints, start, end)
+	case metricdata.Sum[float64]:
+		reasons = timestampsWithinDataPoints(agg.DataPoints, start, end)
+	case metricdata.Histogram[int64]:
+		reasons = timestampsWithinHistogramDataPoints(agg.DataPoints, start, end)
+	case metricdata.Histogram[float64]:
+		reasons = timestampsWithinHistogramDataPoints(agg.DataPoints, start, end)
+	case metricdata.Summary[int64]:
+		reasons = timestampsWithinSummaryDataPoints(agg.DataPoints, start, end)
+	case metricdata.Summary[float64]:
+		reasons = timestampsWithinSummaryDataPoints(agg.DataPoints, start, end)
+	case metricdata.ExponentialHistogram[int64]:
+		reasons = timestampsWithinExponentialHistogramDataPoints(agg.DataPoints, start, end)
+	case metricdata.ExponentialHistogram[float64]:
+		reasons = timestampsWithinExponentialHistogramDataPoints(agg.DataPoints, start, end)
+	default:
+		reasons = []string{fmt.Sprintf("unknown aggregation %T", agg)}
+	}
+	return reasons
+}
+
+func timestampsWithinResourceMetrics(rm metricdata.ResourceMetrics, start, end time.Time) (reasons []string) {
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			reas := timestampsWithinAggregation(m.Data, start, end)
+			if len(reas) > 0 {
+				reasons = append(reasons, fmt.Sprintf("Scope %s Metric %s:", sm.Scope.Name, m.Name))
+				reasons = append(reasons, reas...)
+			}
+		}
+	}
+	return reasons
+}
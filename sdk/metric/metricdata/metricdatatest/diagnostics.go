@@ -0,0 +1,346 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// WithDiagnostics appends a summary to the reasons of a failed assertion
+// that counts the mismatches by the field they are found in. For example:
+//
+//	diagnostics: 3 Value mismatches, 1 Attributes mismatch, 0 Timestamp mismatches (ignored), 0 Exemplar mismatches, 0 other mismatches
+//
+// Categories disabled by IgnoreValue, IgnoreTimestamp, or IgnoreExemplars
+// are marked as ignored. This can be used to quickly determine what still
+// differs when a comparison fails with Options applied.
+//
+// To count mismatches, ScopeMetrics are paired by their Scope, Metrics by
+// their Name (or the key of WithMetricKey), and data points by their
+// Attributes. Elements that cannot be paired with an element of the other
+// value are counted as an Attributes mismatch if they are data points,
+// otherwise as an other mismatch.
+func WithDiagnostics() Option {
+	return fnOption(func(cfg config) config {
+		cfg.diagnostics = true
+		return cfg
+	})
+}
+
+// category is the field category of a mismatch.
+type category int
+
+const (
+	categoryValue category = iota
+	categoryAttributes
+	categoryTimestamp
+	categoryExemplar
+	categoryOther
+
+	numCategories
+)
+
+var categoryNames = [numCategories]string{
+	categoryValue:      "Value",
+	categoryAttributes: "Attributes",
+	categoryTimestamp:  "Timestamp",
+	categoryExemplar:   "Exemplar",
+	categoryOther:      "other",
+}
+
+// tally is the number of mismatches found for each category.
+type tally [numCategories]int
+
+// addReasons adds the reasons returned by a single, non-matching, comparison
+// to t.
+func (t *tally) addReasons(reasons []string) {
+	for _, r := range reasons {
+		if c, ok := categorize(r); ok {
+			t[c]++
+		}
+	}
+}
+
+// categorize returns the category of reason. If reason only introduces
+// following reasons, false is returned.
+func categorize(reason string) (category, bool) {
+	field, _, _ := strings.Cut(reason, " not equal:")
+	switch {
+	case field == "PositiveBucket" || field == "NegativeBucket":
+		// Headers of the following bucket reasons.
+		return 0, false
+	case field == "Attributes":
+		return categoryAttributes, true
+	case field == "StartTime" || field == "Time":
+		return categoryTimestamp, true
+	case field == "Exemplars":
+		return categoryExemplar, true
+	case field == "Value" || field == "Count" || field == "Sum" ||
+		field == "Min" || field == "Max" || field == "Bounds" ||
+		field == "BucketCounts" || field == "Scale" || field == "ZeroCount" ||
+		field == "Offset" || field == "Extrema" || strings.HasPrefix(field, "Counts"):
+		return categoryValue, true
+	}
+	return categoryOther, true
+}
+
+// withDiagnostics returns reasons with a summary of the mismatches between
+// expected and actual appended, if cfg enables diagnostics.
+func (cfg config) withDiagnostics(expected, actual interface{}, reasons []string) []string {
+	if !cfg.diagnostics {
+		return reasons
+	}
+	var t tally
+	diagnose(&t, expected, actual, cfg)
+	return append(reasons, cfg.summary(t))
+}
+
+// summary returns the diagnostics summary of t.
+func (cfg config) summary(t tally) string {
+	ignored := [numCategories]bool{
+		categoryValue:     cfg.ignoreValue,
+		categoryTimestamp: cfg.ignoreTimestamp,
+		categoryExemplar:  cfg.ignoreExemplars,
+	}
+	parts := make([]string, numCategories)
+	for c := category(0); c < numCategories; c++ {
+		noun := "mismatches"
+		if t[c] == 1 {
+			noun = "mismatch"
+		}
+		parts[c] = fmt.Sprintf("%d %s %s", t[c], categoryNames[c], noun)
+		if ignored[c] {
+			parts[c] += " (ignored)"
+		}
+	}
+	return "diagnostics: " + strings.Join(parts, ", ")
+}
+
+// diagnose adds the mismatches between expected and actual to t. Unlike the
+// equal functions, elements are paired by their identity instead of being
+// matched so each mismatch is only counted once.
+func diagnose(t *tally, expected, actual interface{}, cfg config) {
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		eAgg, eOk := expected.(metricdata.Aggregation)
+		aAgg, aOk := actual.(metricdata.Aggregation)
+		var ok bool
+		if eOk && aOk && cfg.numericTypeCoercion {
+			expected, actual, ok = coerceNumericTypes(eAgg, aAgg)
+		}
+		if !ok {
+			t[categoryOther]++
+			return
+		}
+	}
+
+	switch e := expected.(type) {
+	case metricdata.ResourceMetrics:
+		a := actual.(metricdata.ResourceMetrics)
+		t[categoryOther] += len(equalResources(e.Resource, a.Resource, cfg))
+		diagnosePaired(t, cfg, e.ScopeMetrics, a.ScopeMetrics, scopeMetricsKey(cfg),
+			func(t *tally, e, a metricdata.ScopeMetrics) { diagnose(t, e, a, cfg) },
+			categoryOther,
+		)
+	case metricdata.ScopeMetrics:
+		a := actual.(metricdata.ScopeMetrics)
+		diagnosePaired(t, cfg, filterMetrics(e.Metrics, cfg), filterMetrics(a.Metrics, cfg), metricsKey(cfg),
+			func(t *tally, e, a metricdata.Metrics) { diagnose(t, e, a, cfg) },
+			categoryOther,
+		)
+	case metricdata.Metrics:
+		a := actual.(metricdata.Metrics)
+		if e.Name != a.Name {
+			t[categoryOther]++
+		}
+		if e.Description != a.Description {
+			t[categoryOther]++
+		}
+		if e.Unit != a.Unit {
+			t[categoryOther]++
+		}
+		if e.Data == nil || a.Data == nil {
+			if e.Data != a.Data {
+				t[categoryOther]++
+			}
+			return
+		}
+		diagnose(t, e.Data, a.Data, cfg)
+	case metricdata.Gauge[int64]:
+		diagnoseDataPoints(t, cfg, e.DataPoints, actual.(metricdata.Gauge[int64]).DataPoints, dataPointAttrs[int64], equalDataPoints[int64])
+	case metricdata.Gauge[float64]:
+		diagnoseDataPoints(t, cfg, e.DataPoints, actual.(metricdata.Gauge[float64]).DataPoints, dataPointAttrs[float64], equalDataPoints[float64])
+	case metricdata.Sum[int64]:
+		e, a := normalizeSum(e, cfg), normalizeSum(actual.(metricdata.Sum[int64]), cfg)
+		diagnoseAggregationFields(t, cfg, e.Temporality, a.Temporality, e.IsMonotonic, a.IsMonotonic)
+		diagnoseDataPoints(t, cfg, e.DataPoints, a.DataPoints, dataPointAttrs[int64], equalDataPoints[int64])
+	case metricdata.Sum[float64]:
+		e, a := normalizeSum(e, cfg), normalizeSum(actual.(metricdata.Sum[float64]), cfg)
+		diagnoseAggregationFields(t, cfg, e.Temporality, a.Temporality, e.IsMonotonic, a.IsMonotonic)
+		diagnoseDataPoints(t, cfg, e.DataPoints, a.DataPoints, dataPointAttrs[float64], equalDataPoints[float64])
+	case metricdata.Histogram[int64]:
+		e, a := normalizeHistogram(e, cfg), normalizeHistogram(actual.(metricdata.Histogram[int64]), cfg)
+		diagnoseAggregationFields(t, cfg, e.Temporality, a.Temporality, false, false)
+		diagnoseDataPoints(t, cfg, e.DataPoints, a.DataPoints, histogramDataPointAttrs[int64], equalHistogramDataPoints[int64])
+	case metricdata.Histogram[float64]:
+		e, a := normalizeHistogram(e, cfg), normalizeHistogram(actual.(metricdata.Histogram[float64]), cfg)
+		diagnoseAggregationFields(t, cfg, e.Temporality, a.Temporality, false, false)
+		diagnoseDataPoints(t, cfg, e.DataPoints, a.DataPoints, histogramDataPointAttrs[float64], equalHistogramDataPoints[float64])
+	case metricdata.ExponentialHistogram[int64]:
+		a := actual.(metricdata.ExponentialHistogram[int64])
+		diagnoseAggregationFields(t, cfg, e.Temporality, a.Temporality, false, false)
+		diagnoseDataPoints(t, cfg, e.DataPoints, a.DataPoints, exponentialHistogramDataPointAttrs[int64], equalExponentialHistogramDataPoints[int64])
+	case metricdata.ExponentialHistogram[float64]:
+		a := actual.(metricdata.ExponentialHistogram[float64])
+		diagnoseAggregationFields(t, cfg, e.Temporality, a.Temporality, false, false)
+		diagnoseDataPoints(t, cfg, e.DataPoints, a.DataPoints, exponentialHistogramDataPointAttrs[float64], equalExponentialHistogramDataPoints[float64])
+	default:
+		// Data points, Exemplars, Extrema, and ExponentialBuckets do not
+		// contain elements that need to be paired.
+		if r, ok := equalIfaces(expected, actual, cfg); ok {
+			t.addReasons(r)
+		} else {
+			t[categoryOther]++
+		}
+	}
+}
+
+func diagnoseAggregationFields(t *tally, cfg config, eTemp, aTemp metricdata.Temporality, eMono, aMono bool) {
+	if !cfg.ignoreTemporality && eTemp != aTemp {
+		t[categoryOther]++
+	}
+	if !cfg.ignoreMonotonicity && eMono != aMono {
+		t[categoryOther]++
+	}
+}
+
+// diagnosePaired adds the mismatches between the elements of expected and
+// actual that have the same key to t. Elements without a pair are added to t
+// as an unpaired mismatch.
+func diagnosePaired[T any, K comparable](t *tally, cfg config, expected, actual []T, key func(T) K, pair func(*tally, T, T), unpaired category) {
+	pairs, onlyE, onlyA := pairIndexes(expected, actual, key)
+	for _, p := range pairs {
+		pair(t, expected[p[0]], actual[p[1]])
+	}
+	t[unpaired] += len(onlyE)
+	if !cfg.subset {
+		t[unpaired] += len(onlyA)
+	}
+}
+
+// diagnoseDataPoints adds the mismatches between the data points of expected
+// and actual to t. Data points are paired by their attributes, after the
+// attribute filter of cfg is applied.
+func diagnoseDataPoints[T any](t *tally, cfg config, expected, actual []T, attrs func(T) attribute.Set, equal func(T, T, config) []string) {
+	pair := func(t *tally, e, a T) {
+		t.addReasons(equal(e, a, cfg))
+	}
+	diagnosePaired(t, cfg, expected, actual, dataPointKey(cfg, attrs), pair, categoryAttributes)
+}
+
+// pairIndexes pairs the indexes of the elements of expected and actual with
+// the same key. Elements with the same key are paired in order. The indexes
+// of the elements without a pair are returned in onlyE and onlyA.
+func pairIndexes[T any, K comparable](expected, actual []T, key func(T) K) (pairs [][2]int, onlyE, onlyA []int) {
+	remaining := make(map[K][]int, len(actual))
+	for j, a := range actual {
+		k := key(a)
+		remaining[k] = append(remaining[k], j)
+	}
+	for i, e := range expected {
+		k := key(e)
+		if len(remaining[k]) == 0 {
+			onlyE = append(onlyE, i)
+			continue
+		}
+		pairs = append(pairs, [2]int{i, remaining[k][0]})
+		remaining[k] = remaining[k][1:]
+	}
+	for j, a := range actual {
+		k := key(a)
+		if len(remaining[k]) > 0 && remaining[k][0] == j {
+			onlyA = append(onlyA, j)
+			remaining[k] = remaining[k][1:]
+		}
+	}
+	return pairs, onlyE, onlyA
+}
+
+// pairPositions pairs the indexes of two slices of length nE and nA by their
+// position.
+func pairPositions(nE, nA int) (pairs [][2]int, onlyE, onlyA []int) {
+	for i := 0; i < nE || i < nA; i++ {
+		switch {
+		case i >= nA:
+			onlyE = append(onlyE, i)
+		case i >= nE:
+			onlyA = append(onlyA, i)
+		default:
+			pairs = append(pairs, [2]int{i, i})
+		}
+	}
+	return pairs, onlyE, onlyA
+}
+
+// scopeMetricsKey returns the key ScopeMetrics are paired by.
+func scopeMetricsKey(cfg config) func(metricdata.ScopeMetrics) instrumentation.Scope {
+	return func(sm metricdata.ScopeMetrics) instrumentation.Scope {
+		if cfg.ignoreScopeVersion {
+			sm.Scope.Version = ""
+		}
+		return sm.Scope
+	}
+}
+
+// metricsKey returns the key Metrics are paired by.
+func metricsKey(cfg config) func(metricdata.Metrics) string {
+	if cfg.metricKey != nil {
+		return cfg.metricKey
+	}
+	return func(m metricdata.Metrics) string { return m.Name }
+}
+
+// dataPointKey returns the key data points are paired by: their attributes,
+// after the attribute filter of cfg is applied.
+func dataPointKey[T any](cfg config, attrs func(T) attribute.Set) func(T) attribute.Distinct {
+	return func(dp T) attribute.Distinct {
+		set := attrs(dp)
+		if cfg.attributeFilter != nil {
+			set, _ = set.Filter(cfg.attributeFilter)
+		}
+		return set.Equivalent()
+	}
+}
+
+func dataPointAttrs[N int64 | float64](dp metricdata.DataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func histogramDataPointAttrs[N int64 | float64](dp metricdata.HistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func exponentialHistogramDataPointAttrs[N int64 | float64](dp metricdata.ExponentialHistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func summaryDataPointAttrs[N int64 | float64](dp metricdata.SummaryDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
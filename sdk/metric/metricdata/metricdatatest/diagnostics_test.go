@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestWithDiagnostics(t *testing.T) {
+	attrC := attribute.NewSet(attribute.Bool("C", true))
+	expected := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: attrA, StartTime: startA, Time: endA, Value: 1},
+			{Attributes: attrB, StartTime: startA, Time: endA, Value: 2},
+			{Attributes: attrC, StartTime: startA, Time: endA, Value: 3},
+		},
+	}
+	actual := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: attrA, StartTime: startB, Time: endB, Value: 10},
+			{Attributes: attrB, StartTime: startB, Time: endB, Value: 20},
+		},
+	}
+
+	rt := new(recordingT)
+	assert.False(t, AssertEqual(rt, expected, actual, IgnoreTimestamp(), WithDiagnostics()))
+	require.Len(t, rt.errors, 1)
+	reasons, ok := rt.errors[0][0].([]string)
+	require.True(t, ok)
+	want := "diagnostics: 2 Value mismatches, 1 Attributes mismatch, 0 Timestamp mismatches (ignored), 0 Exemplar mismatches, 0 other mismatches"
+	assert.Equal(t, want, reasons[len(reasons)-1])
+
+	rt = new(recordingT)
+	assert.False(t, AssertEqual(rt, expected, actual))
+	require.Len(t, rt.errors, 1)
+	assert.NotContains(t, rt.errors[0][0], want, "diagnostics without option")
+}
+
+func TestDiagnose(t *testing.T) {
+	cfg := newConfig([]Option{WithDiagnostics()})
+
+	var got tally
+	diagnose(&got, resourceMetricsA, resourceMetricsB, cfg)
+	assert.NotEqual(t, tally{}, got)
+
+	got = tally{}
+	diagnose(&got, metricsA, metricsA, cfg)
+	assert.Equal(t, tally{}, got, "equal values have no mismatches")
+
+	got = tally{}
+	diagnose(&got, histogramDataPointInt64A, histogramDataPointInt64B, cfg)
+	assert.Equal(t, 1, got[categoryAttributes])
+	assert.Equal(t, 2, got[categoryTimestamp])
+
+	got = tally{}
+	diagnose(&got, sumInt64A, gaugeInt64A, cfg)
+	assert.Equal(t, tally{categoryOther: 1}, got, "aggregation type mismatch")
+}
+
+func TestCategorize(t *testing.T) {
+	for _, tc := range []struct {
+		reason string
+		want   category
+	}{
+		{notEqualValueStr[int64]("Value", 1, 2), categoryValue},
+		{notEqualStr("BucketCounts", []uint64{1}, []uint64{2}), categoryValue},
+		{notEqualStr("Counts[0] (bucket index 1)", 1, 2), categoryValue},
+		{notEqualStr("Attributes", "A=true", "B=true"), categoryAttributes},
+		{notEqualStr("StartTime", 1, 2), categoryTimestamp},
+		{notEqualStr("Time", 1, 2), categoryTimestamp},
+		{"Exemplars not equal:\nmissing expected values:\n", categoryExemplar},
+		{notEqualStr("Temporality", 1, 2), categoryOther},
+	} {
+		got, ok := categorize(tc.reason)
+		assert.True(t, ok, tc.reason)
+		assert.Equal(t, tc.want, got, tc.reason)
+	}
+
+	_, ok := categorize("PositiveBucket not equal:")
+	assert.False(t, ok, "bucket headers should not be counted")
+}
@@ -0,0 +1,354 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// Dump returns a human readable, indented rendering of v.
+//
+// The value v is expected to be one of the Datatypes, an Aggregation, or
+// one of the types they are composed of. Attributes are rendered as sorted
+// key=value pairs and timestamps are rendered in RFC 3339 format in UTC. The
+// output is deterministic for equal inputs. Values of any other type are
+// rendered using their default format.
+func Dump(v any) string {
+	var d dumper
+	d.value(v)
+	return strings.TrimSuffix(d.b.String(), "\n")
+}
+
+// WithFormatter sets the function used to format the values reported as
+// missing or unexpected by failed assertions. This can be used to render
+// large values, like ExponentialHistogram data points with many buckets, in
+// a more compact form.
+//
+// By default, values are formatted with Dump. The format function is passed
+// the missing or unexpected values, like ScopeMetrics, Metrics, data points,
+// or Exemplars.
+func WithFormatter(format func(any) string) Option {
+	return fnOption(func(cfg config) config {
+		cfg.formatter = format
+		return cfg
+	})
+}
+
+// format returns v formatted with the formatter of cfg, or Dump if cfg has
+// none.
+func (cfg config) format(v any) string {
+	if cfg.formatter != nil {
+		return cfg.formatter(v)
+	}
+	return Dump(v)
+}
+
+// dumper writes indented lines to a buffer.
+type dumper struct {
+	b      strings.Builder
+	indent int
+}
+
+func (d *dumper) line(format string, args ...any) {
+	_, _ = d.b.WriteString(strings.Repeat("  ", d.indent))
+	_, _ = fmt.Fprintf(&d.b, format, args...)
+	_ = d.b.WriteByte('\n')
+}
+
+// nest calls f with the indentation level increased by one.
+func (d *dumper) nest(f func()) {
+	d.indent++
+	f()
+	d.indent--
+}
+
+func (d *dumper) value(v any) {
+	switch v := v.(type) {
+	case metricdata.ResourceMetrics:
+		d.resourceMetrics(v)
+	case metricdata.ScopeMetrics:
+		d.scopeMetrics(v)
+	case metricdata.Metrics:
+		d.metrics(v)
+	case metricdata.Gauge[int64]:
+		dumpGauge(d, v)
+	case metricdata.Gauge[float64]:
+		dumpGauge(d, v)
+	case metricdata.Sum[int64]:
+		dumpSum(d, v)
+	case metricdata.Sum[float64]:
+		dumpSum(d, v)
+	case metricdata.Histogram[int64]:
+		dumpHistogram(d, v)
+	case metricdata.Histogram[float64]:
+		dumpHistogram(d, v)
+	case metricdata.ExponentialHistogram[int64]:
+		dumpExponentialHistogram(d, v)
+	case metricdata.ExponentialHistogram[float64]:
+		dumpExponentialHistogram(d, v)
+	case metricdata.DataPoint[int64]:
+		dumpDataPoint(d, v)
+	case metricdata.DataPoint[float64]:
+		dumpDataPoint(d, v)
+	case metricdata.HistogramDataPoint[int64]:
+		dumpHistogramDataPoint(d, v)
+	case metricdata.HistogramDataPoint[float64]:
+		dumpHistogramDataPoint(d, v)
+	case metricdata.ExponentialHistogramDataPoint[int64]:
+		dumpExponentialHistogramDataPoint(d, v)
+	case metricdata.ExponentialHistogramDataPoint[float64]:
+		dumpExponentialHistogramDataPoint(d, v)
+	case metricdata.Exemplar[int64]:
+		dumpExemplar(d, v)
+	case metricdata.Exemplar[float64]:
+		dumpExemplar(d, v)
+	case metricdata.Extrema[int64]:
+		d.line("Extrema[int64]: %s", fmtExtrema(v))
+	case metricdata.Extrema[float64]:
+		d.line("Extrema[float64]: %s", fmtExtrema(v))
+	case metricdata.ExponentialBucket:
+		d.line("ExponentialBucket: %s", fmtExponentialBucket(v))
+	case attribute.Set:
+		d.line("%s", fmtSet(v))
+	case *resource.Resource:
+		d.line("%s", fmtResource(v))
+	case instrumentation.Scope:
+		d.line("%s", fmtScope(v))
+	case nil:
+		d.line("<nil>")
+	default:
+		d.line("%v", v)
+	}
+}
+
+func (d *dumper) resourceMetrics(rm metricdata.ResourceMetrics) {
+	d.line("ResourceMetrics")
+	d.nest(func() {
+		d.line("Resource: %s", fmtResource(rm.Resource))
+		d.line("ScopeMetrics:")
+		d.nest(func() {
+			for _, sm := range rm.ScopeMetrics {
+				d.scopeMetrics(sm)
+			}
+		})
+	})
+}
+
+func (d *dumper) scopeMetrics(sm metricdata.ScopeMetrics) {
+	d.line("ScopeMetrics")
+	d.nest(func() {
+		d.line("Scope: %s", fmtScope(sm.Scope))
+		d.line("Metrics:")
+		d.nest(func() {
+			for _, m := range sm.Metrics {
+				d.metrics(m)
+			}
+		})
+	})
+}
+
+func (d *dumper) metrics(m metricdata.Metrics) {
+	d.line("Metrics")
+	d.nest(func() {
+		d.line("Name: %s", m.Name)
+		d.line("Description: %s", m.Description)
+		d.line("Unit: %s", m.Unit)
+		d.line("Data:")
+		d.nest(func() { d.value(m.Data) })
+	})
+}
+
+func dumpGauge[N int64 | float64](d *dumper, g metricdata.Gauge[N]) {
+	d.line("Gauge[%T]", *new(N))
+	d.nest(func() {
+		d.line("DataPoints:")
+		d.nest(func() {
+			for _, dp := range g.DataPoints {
+				dumpDataPoint(d, dp)
+			}
+		})
+	})
+}
+
+func dumpSum[N int64 | float64](d *dumper, s metricdata.Sum[N]) {
+	d.line("Sum[%T]", *new(N))
+	d.nest(func() {
+		d.line("Temporality: %s", s.Temporality)
+		d.line("IsMonotonic: %t", s.IsMonotonic)
+		d.line("DataPoints:")
+		d.nest(func() {
+			for _, dp := range s.DataPoints {
+				dumpDataPoint(d, dp)
+			}
+		})
+	})
+}
+
+func dumpHistogram[N int64 | float64](d *dumper, h metricdata.Histogram[N]) {
+	d.line("Histogram[%T]", *new(N))
+	d.nest(func() {
+		d.line("Temporality: %s", h.Temporality)
+		d.line("DataPoints:")
+		d.nest(func() {
+			for _, dp := range h.DataPoints {
+				dumpHistogramDataPoint(d, dp)
+			}
+		})
+	})
+}
+
+func dumpExponentialHistogram[N int64 | float64](d *dumper, h metricdata.ExponentialHistogram[N]) {
+	d.line("ExponentialHistogram[%T]", *new(N))
+	d.nest(func() {
+		d.line("Temporality: %s", h.Temporality)
+		d.line("DataPoints:")
+		d.nest(func() {
+			for _, dp := range h.DataPoints {
+				dumpExponentialHistogramDataPoint(d, dp)
+			}
+		})
+	})
+}
+
+func dumpDataPoint[N int64 | float64](d *dumper, dp metricdata.DataPoint[N]) {
+	d.line("DataPoint[%T]", *new(N))
+	d.nest(func() {
+		d.line("Attributes: %s", fmtSet(dp.Attributes))
+		d.line("StartTime: %s", fmtTime(dp.StartTime))
+		d.line("Time: %s", fmtTime(dp.Time))
+		d.line("Value: %v", dp.Value)
+		dumpExemplars(d, dp.Exemplars)
+	})
+}
+
+func dumpHistogramDataPoint[N int64 | float64](d *dumper, dp metricdata.HistogramDataPoint[N]) {
+	d.line("HistogramDataPoint[%T]", *new(N))
+	d.nest(func() {
+		d.line("Attributes: %s", fmtSet(dp.Attributes))
+		d.line("StartTime: %s", fmtTime(dp.StartTime))
+		d.line("Time: %s", fmtTime(dp.Time))
+		d.line("Count: %d", dp.Count)
+		d.line("Bounds: %v", dp.Bounds)
+		d.line("BucketCounts: %v", dp.BucketCounts)
+		d.line("Min: %s", fmtExtrema(dp.Min))
+		d.line("Max: %s", fmtExtrema(dp.Max))
+		d.line("Sum: %v", dp.Sum)
+		dumpExemplars(d, dp.Exemplars)
+	})
+}
+
+func dumpExponentialHistogramDataPoint[N int64 | float64](d *dumper, dp metricdata.ExponentialHistogramDataPoint[N]) {
+	d.line("ExponentialHistogramDataPoint[%T]", *new(N))
+	d.nest(func() {
+		d.line("Attributes: %s", fmtSet(dp.Attributes))
+		d.line("StartTime: %s", fmtTime(dp.StartTime))
+		d.line("Time: %s", fmtTime(dp.Time))
+		d.line("Count: %d", dp.Count)
+		d.line("Min: %s", fmtExtrema(dp.Min))
+		d.line("Max: %s", fmtExtrema(dp.Max))
+		d.line("Sum: %v", dp.Sum)
+		d.line("Scale: %d", dp.Scale)
+		d.line("ZeroCount: %d", dp.ZeroCount)
+		d.line("ZeroThreshold: %v", dp.ZeroThreshold)
+		d.line("PositiveBucket: %s", fmtExponentialBucket(dp.PositiveBucket))
+		d.line("NegativeBucket: %s", fmtExponentialBucket(dp.NegativeBucket))
+		dumpExemplars(d, dp.Exemplars)
+	})
+}
+
+func dumpExemplars[N int64 | float64](d *dumper, exemplars []metricdata.Exemplar[N]) {
+	if len(exemplars) == 0 {
+		return
+	}
+	d.line("Exemplars:")
+	d.nest(func() {
+		for _, e := range exemplars {
+			dumpExemplar(d, e)
+		}
+	})
+}
+
+func dumpExemplar[N int64 | float64](d *dumper, e metricdata.Exemplar[N]) {
+	d.line("Exemplar[%T]", *new(N))
+	d.nest(func() {
+		d.line("FilteredAttributes: %s", fmtKeyValues(e.FilteredAttributes))
+		d.line("Time: %s", fmtTime(e.Time))
+		d.line("Value: %v", e.Value)
+		d.line("SpanID: %x", e.SpanID)
+		d.line("TraceID: %x", e.TraceID)
+	})
+}
+
+func fmtResource(r *resource.Resource) string {
+	if r == nil {
+		return "<nil>"
+	}
+	if r.SchemaURL() == "" {
+		return fmtSet(*r.Set())
+	}
+	return fmt.Sprintf("%s (schema URL: %s)", fmtSet(*r.Set()), r.SchemaURL())
+}
+
+func fmtScope(s instrumentation.Scope) string {
+	return fmt.Sprintf("name=%q version=%q schemaURL=%q", s.Name, s.Version, s.SchemaURL)
+}
+
+// fmtSet renders s as a set of key=value pairs. The attribute.Set is already
+// sorted by key.
+func fmtSet(s attribute.Set) string {
+	return fmtSortedKeyValues(s.ToSlice())
+}
+
+// fmtKeyValues renders kvs as a set of key=value pairs sorted by key.
+func fmtKeyValues(kvs []attribute.KeyValue) string {
+	sorted := make([]attribute.KeyValue, len(kvs))
+	copy(sorted, kvs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Key < sorted[j].Key
+	})
+	return fmtSortedKeyValues(sorted)
+}
+
+func fmtSortedKeyValues(kvs []attribute.KeyValue) string {
+	pairs := make([]string, len(kvs))
+	for i, kv := range kvs {
+		pairs[i] = fmt.Sprintf("%s=%s", kv.Key, kv.Value.Emit())
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+func fmtTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func fmtExtrema[N int64 | float64](e metricdata.Extrema[N]) string {
+	v, ok := e.Value()
+	if !ok {
+		return "<undefined>"
+	}
+	return fmt.Sprint(v)
+}
+
+func fmtExponentialBucket(b metricdata.ExponentialBucket) string {
+	return fmt.Sprintf("Offset=%d Counts=%v", b.Offset, b.Counts)
+}
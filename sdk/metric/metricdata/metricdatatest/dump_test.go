@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestDump(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Second)
+
+	rm := metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service", "test")),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "scope", Version: "v0.1.0"},
+			Metrics: []metricdata.Metrics{{
+				Name:        "requests",
+				Description: "number of requests",
+				Unit:        "1",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{{
+						Attributes: attribute.NewSet(
+							attribute.String("b", "2"),
+							attribute.Int("a", 1),
+						),
+						StartTime: start,
+						Time:      end,
+						Value:     3,
+						Exemplars: []metricdata.Exemplar[int64]{{
+							FilteredAttributes: []attribute.KeyValue{
+								attribute.Bool("z", true),
+								attribute.Bool("y", false),
+							},
+							Time:    end,
+							Value:   1,
+							SpanID:  []byte{0, 1},
+							TraceID: []byte{0, 2},
+						}},
+					}},
+				},
+			}},
+		}},
+	}
+
+	want := `ResourceMetrics
+  Resource: {service=test}
+  ScopeMetrics:
+    ScopeMetrics
+      Scope: name="scope" version="v0.1.0" schemaURL=""
+      Metrics:
+        Metrics
+          Name: requests
+          Description: number of requests
+          Unit: 1
+          Data:
+            Sum[int64]
+              Temporality: CumulativeTemporality
+              IsMonotonic: true
+              DataPoints:
+                DataPoint[int64]
+                  Attributes: {a=1, b=2}
+                  StartTime: 2023-01-01T00:00:00Z
+                  Time: 2023-01-01T00:00:01Z
+                  Value: 3
+                  Exemplars:
+                    Exemplar[int64]
+                      FilteredAttributes: {y=false, z=true}
+                      Time: 2023-01-01T00:00:01Z
+                      Value: 1
+                      SpanID: 0001
+                      TraceID: 0002`
+	assert.Equal(t, want, Dump(rm))
+	assert.Equal(t, Dump(rm), Dump(rm), "output not deterministic")
+}
+
+func TestDumpDatatypes(t *testing.T) {
+	// All data-types need to be rendered without falling back to the
+	// default format.
+	values := []any{
+		resourceMetricsA,
+		scopeMetricsA,
+		metricsA,
+		gaugeInt64A,
+		gaugeFloat64A,
+		sumInt64A,
+		sumFloat64A,
+		histogramInt64A,
+		histogramFloat64A,
+		exponentialHistogramInt64A,
+		exponentialHistogramFloat64A,
+		dataPointInt64A,
+		dataPointFloat64A,
+		histogramDataPointInt64A,
+		histogramDataPointFloat64A,
+		exponentialHistogramDataPointInt64A,
+		exponentialHistogramDataPointFloat64A,
+		exemplarInt64A,
+		exemplarFloat64A,
+		minInt64A,
+		minFloat64A,
+		exponentialBucket2,
+	}
+	for _, v := range values {
+		assert.NotEqual(t, fmt.Sprint(v), Dump(v), "using default format: %T", v)
+	}
+}
+
+func TestDumpExtrema(t *testing.T) {
+	assert.Equal(t, "Extrema[int64]: 3", Dump(metricdata.NewExtrema[int64](3)))
+	assert.Equal(t, "Extrema[float64]: <undefined>", Dump(metricdata.Extrema[float64]{}))
+}
+
+func TestDumpNil(t *testing.T) {
+	assert.Equal(t, "<nil>", Dump(nil))
+	assert.Contains(t, Dump(metricdata.ResourceMetrics{}), "Resource: <nil>")
+}
+
+func TestWithFormatter(t *testing.T) {
+	format := func(v any) string {
+		dp, ok := v.(metricdata.DataPoint[int64])
+		if !ok {
+			return fmt.Sprintf("%T", v)
+		}
+		return fmt.Sprintf("%s=%d", dp.Attributes.Encoded(attribute.DefaultEncoder()), dp.Value)
+	}
+	a := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{dataPointInt64A}}
+	b := metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{dataPointInt64B}}
+
+	_, r := Equal(a, b, WithFormatter(format))
+	require.Len(t, r, 1)
+	assert.Equal(t, "Sum DataPoints not equal:\nmissing expected values:\nA=true=-1\nunexpected additional values:\nB=true=2\n", r[0])
+
+	rT := new(recordingT)
+	AssertNotEqual(rT, a, a, WithFormatter(format))
+	assert.Equal(t, [][]any{{[]string{"values are unexpectedly equal:\nmetricdata.Sum[int64]"}}}, rT.errors)
+
+	diffs := Diff(a, b, WithFormatter(format))
+	require.Len(t, diffs, 2)
+	assert.Equal(t, "missing expected value:\nA=true=-1", diffs[0].Details)
+}
@@ -0,0 +1,480 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// EqualFast returns if the two concrete data-types from the metricdata
+// package are equal. The comparison is the same as Equal, but it stops at the
+// first difference found and does not build reasons. Comparing equal values
+// does not allocate unless Options that require it, like MatchAttributeKeys,
+// are used.
+//
+// This is intended for code that runs many comparisons and only needs to
+// know if values are equal, like fuzz tests. Use Equal or AssertEqual to
+// determine why values are not equal.
+func EqualFast[T Datatypes](expected, actual T, opts ...Option) bool {
+	cfg := newConfig(opts)
+
+	// Generic types cannot be type asserted. Use an interface instead.
+	aIface := interface{}(actual)
+	switch e := interface{}(expected).(type) {
+	case metricdata.Exemplar[int64]:
+		return eqExemplars(e, aIface.(metricdata.Exemplar[int64]), cfg)
+	case metricdata.Exemplar[float64]:
+		return eqExemplars(e, aIface.(metricdata.Exemplar[float64]), cfg)
+	case metricdata.DataPoint[int64]:
+		return eqDataPoints(e, aIface.(metricdata.DataPoint[int64]), cfg)
+	case metricdata.DataPoint[float64]:
+		return eqDataPoints(e, aIface.(metricdata.DataPoint[float64]), cfg)
+	case metricdata.Gauge[int64]:
+		return eqGauges(e, aIface.(metricdata.Gauge[int64]), cfg)
+	case metricdata.Gauge[float64]:
+		return eqGauges(e, aIface.(metricdata.Gauge[float64]), cfg)
+	case metricdata.Histogram[float64]:
+		return eqHistograms(e, aIface.(metricdata.Histogram[float64]), cfg)
+	case metricdata.Histogram[int64]:
+		return eqHistograms(e, aIface.(metricdata.Histogram[int64]), cfg)
+	case metricdata.HistogramDataPoint[float64]:
+		return eqHistogramDataPoints(e, aIface.(metricdata.HistogramDataPoint[float64]), cfg)
+	case metricdata.HistogramDataPoint[int64]:
+		return eqHistogramDataPoints(e, aIface.(metricdata.HistogramDataPoint[int64]), cfg)
+	case metricdata.Summary[float64]:
+		return eqSummaries(e, aIface.(metricdata.Summary[float64]), cfg)
+	case metricdata.Summary[int64]:
+		return eqSummaries(e, aIface.(metricdata.Summary[int64]), cfg)
+	case metricdata.SummaryDataPoint[float64]:
+		return eqSummaryDataPoints(e, aIface.(metricdata.SummaryDataPoint[float64]), cfg)
+	case metricdata.SummaryDataPoint[int64]:
+		return eqSummaryDataPoints(e, aIface.(metricdata.SummaryDataPoint[int64]), cfg)
+	case metricdata.Extrema[int64]:
+		return eqExtrema(e, aIface.(metricdata.Extrema[int64]), cfg)
+	case metricdata.Extrema[float64]:
+		return eqExtrema(e, aIface.(metricdata.Extrema[float64]), cfg)
+	case metricdata.Metrics:
+		return eqMetrics(e, aIface.(metricdata.Metrics), cfg)
+	case metricdata.ResourceMetrics:
+		return eqResourceMetrics(e, aIface.(metricdata.ResourceMetrics), cfg)
+	case metricdata.ScopeMetrics:
+		return eqScopeMetrics(e, aIface.(metricdata.ScopeMetrics), cfg)
+	case metricdata.Sum[int64]:
+		return eqSums(e, aIface.(metricdata.Sum[int64]), cfg)
+	case metricdata.Sum[float64]:
+		return eqSums(e, aIface.(metricdata.Sum[float64]), cfg)
+	case metricdata.ExponentialHistogram[float64]:
+		return eqExponentialHistograms(e, aIface.(metricdata.ExponentialHistogram[float64]), cfg)
+	case metricdata.ExponentialHistogram[int64]:
+		return eqExponentialHistograms(e, aIface.(metricdata.ExponentialHistogram[int64]), cfg)
+	case metricdata.ExponentialHistogramDataPoint[float64]:
+		return eqExponentialHistogramDataPoints(e, aIface.(metricdata.ExponentialHistogramDataPoint[float64]), cfg)
+	case metricdata.ExponentialHistogramDataPoint[int64]:
+		return eqExponentialHistogramDataPoints(e, aIface.(metricdata.ExponentialHistogramDataPoint[int64]), cfg)
+	case metricdata.ExponentialBucket:
+		a := aIface.(metricdata.ExponentialBucket)
+		return eqExponentialBuckets(e, sumCounts(e.Counts), a, sumCounts(a.Counts), cfg)
+	default:
+		// We control all types passed to this, panic to signal developers
+		// early they changed things in an incompatible way.
+		panic(fmt.Sprintf("unknown types: %T", expected))
+	}
+}
+
+func eqResourceMetrics(a, b metricdata.ResourceMetrics, cfg config) bool {
+	if len(equalResources(a.Resource, b.Resource, cfg)) > 0 {
+		return false
+	}
+	return matchSlices(cfg, a.ScopeMetrics, b.ScopeMetrics, func(a, b metricdata.ScopeMetrics) bool {
+		return eqScopeMetrics(a, b, cfg)
+	})
+}
+
+func eqScopeMetrics(a, b metricdata.ScopeMetrics, cfg config) bool {
+	if cfg.ignoreScopeVersion {
+		a.Scope.Version, b.Scope.Version = "", ""
+	}
+	if a.Scope != b.Scope {
+		return false
+	}
+	equal := func(a, b metricdata.Metrics) bool {
+		return eqMetrics(a, b, cfg)
+	}
+	aMetrics, bMetrics := filterMetrics(a.Metrics, cfg), filterMetrics(b.Metrics, cfg)
+	if cfg.metricKey != nil {
+		return matchPairs(cfg, aMetrics, bMetrics, cfg.metricKey, equal)
+	}
+	return matchSlices(cfg, aMetrics, bMetrics, equal)
+}
+
+func eqMetrics(a, b metricdata.Metrics, cfg config) bool {
+	return a.Name == b.Name &&
+		a.Description == b.Description &&
+		a.Unit == b.Unit &&
+		eqAggregations(a.Data, b.Data, cfg)
+}
+
+func eqAggregations(a, b metricdata.Aggregation, cfg config) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		var ok bool
+		if cfg.numericTypeCoercion {
+			a, b, ok = coerceNumericTypes(a, b)
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	switch v := a.(type) {
+	case metricdata.Gauge[int64]:
+		return eqGauges(v, b.(metricdata.Gauge[int64]), cfg)
+	case metricdata.Gauge[float64]:
+		return eqGauges(v, b.(metricdata.Gauge[float64]), cfg)
+	case metricdata.Sum[int64]:
+		return eqSums(v, b.(metricdata.Sum[int64]), cfg)
+	case metricdata.Sum[float64]:
+		return eqSums(v, b.(metricdata.Sum[float64]), cfg)
+	case metricdata.Histogram[int64]:
+		return eqHistograms(v, b.(metricdata.Histogram[int64]), cfg)
+	case metricdata.Histogram[float64]:
+		return eqHistograms(v, b.(metricdata.Histogram[float64]), cfg)
+	case metricdata.ExponentialHistogram[int64]:
+		return eqExponentialHistograms(v, b.(metricdata.ExponentialHistogram[int64]), cfg)
+	case metricdata.ExponentialHistogram[float64]:
+		return eqExponentialHistograms(v, b.(metricdata.ExponentialHistogram[float64]), cfg)
+	case metricdata.Summary[int64]:
+		return eqSummaries(v, b.(metricdata.Summary[int64]), cfg)
+	case metricdata.Summary[float64]:
+		return eqSummaries(v, b.(metricdata.Summary[float64]), cfg)
+	}
+	return len(equalAggregations(a, b, cfg)) == 0
+}
+
+func eqGauges[N int64 | float64](a, b metricdata.Gauge[N], cfg config) bool {
+	if len(duplicateSeries(cfg, a.DataPoints, b.DataPoints, dataPointAttrs[N])) > 0 {
+		return false
+	}
+	return matchDataPoints(cfg, a.DataPoints, b.DataPoints, func(a, b metricdata.DataPoint[N]) bool {
+		return eqDataPoints(a, b, cfg)
+	})
+}
+
+func eqSums[N int64 | float64](a, b metricdata.Sum[N], cfg config) bool {
+	a, b = normalizeSum(a, cfg), normalizeSum(b, cfg)
+	if !cfg.ignoreTemporality && a.Temporality != b.Temporality {
+		return false
+	}
+	if !cfg.ignoreMonotonicity && a.IsMonotonic != b.IsMonotonic {
+		return false
+	}
+	if len(duplicateSeries(cfg, a.DataPoints, b.DataPoints, dataPointAttrs[N])) > 0 {
+		return false
+	}
+	return matchDataPoints(cfg, a.DataPoints, b.DataPoints, func(a, b metricdata.DataPoint[N]) bool {
+		return eqDataPoints(a, b, cfg)
+	})
+}
+
+func eqHistograms[N int64 | float64](a, b metricdata.Histogram[N], cfg config) bool {
+	a, b = normalizeHistogram(a, cfg), normalizeHistogram(b, cfg)
+	if !cfg.ignoreTemporality && a.Temporality != b.Temporality {
+		return false
+	}
+	if len(duplicateSeries(cfg, a.DataPoints, b.DataPoints, histogramDataPointAttrs[N])) > 0 {
+		return false
+	}
+	return matchDataPoints(cfg, a.DataPoints, b.DataPoints, func(a, b metricdata.HistogramDataPoint[N]) bool {
+		return eqHistogramDataPoints(a, b, cfg)
+	})
+}
+
+func eqExponentialHistograms[N int64 | float64](a, b metricdata.ExponentialHistogram[N], cfg config) bool {
+	if !cfg.ignoreTemporality && a.Temporality != b.Temporality {
+		return false
+	}
+	if len(duplicateSeries(cfg, a.DataPoints, b.DataPoints, exponentialHistogramDataPointAttrs[N])) > 0 {
+		return false
+	}
+	return matchDataPoints(cfg, a.DataPoints, b.DataPoints, func(a, b metricdata.ExponentialHistogramDataPoint[N]) bool {
+		return eqExponentialHistogramDataPoints(a, b, cfg)
+	})
+}
+
+func eqSummaries[N int64 | float64](a, b metricdata.Summary[N], cfg config) bool {
+	if !cfg.ignoreTemporality && a.Temporality != b.Temporality {
+		return false
+	}
+	if len(duplicateSeries(cfg, a.DataPoints, b.DataPoints, summaryDataPointAttrs[N])) > 0 {
+		return false
+	}
+	return matchDataPoints(cfg, a.DataPoints, b.DataPoints, func(a, b metricdata.SummaryDataPoint[N]) bool {
+		return eqSummaryDataPoints(a, b, cfg)
+	})
+}
+
+func eqDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config) bool {
+	if !eqAttributes(a.Attributes, b.Attributes, cfg) {
+		return false
+	}
+	if !cfg.ignoreTimestamp {
+		if !equalStartTimes(a.StartTime, b.StartTime, cfg) || !a.Time.Equal(b.Time) {
+			return false
+		}
+	}
+	if !cfg.ignoreValue && !equalDataPointValues(a.Value, b.Value, cfg) {
+		return false
+	}
+	return eqExemplarSlices(a.Exemplars, b.Exemplars, cfg)
+}
+
+func eqHistogramDataPoints[N int64 | float64](a, b metricdata.HistogramDataPoint[N], cfg config) bool {
+	if !eqAttributes(a.Attributes, b.Attributes, cfg) {
+		return false
+	}
+	if !cfg.ignoreTimestamp {
+		if !equalStartTimes(a.StartTime, b.StartTime, cfg) || !a.Time.Equal(b.Time) {
+			return false
+		}
+	}
+	if !cfg.ignoreValue && cfg.histogramDistributions {
+		if len(equalHistogramDistributions(a, b, cfg)) > 0 {
+			return false
+		}
+	} else if !cfg.ignoreValue {
+		if !cfg.normalizeBucketCounts && a.Count != b.Count {
+			return false
+		}
+		aBounds, aCounts := a.Bounds, a.BucketCounts
+		bBounds, bCounts := b.Bounds, b.BucketCounts
+		if cfg.ignoreTrailingZeroBuckets {
+			aBounds, aCounts = trimTrailingZeroBuckets(aBounds, aCounts)
+			bBounds, bCounts = trimTrailingZeroBuckets(bBounds, bCounts)
+		}
+		if !equalSlices(aBounds, bBounds) || !equalBucketCounts(aCounts, a.Count, bCounts, b.Count, cfg) {
+			return false
+		}
+		if !cfg.ignoreHistogramMinMax && (!eqExtrema(a.Min, b.Min, cfg) || !eqExtrema(a.Max, b.Max, cfg)) {
+			return false
+		}
+		if !cfg.ignoreHistogramSum && !cfg.normalizeBucketCounts && !equalValues(a.Sum, b.Sum, cfg) {
+			return false
+		}
+	}
+	return eqExemplarSlices(a.Exemplars, b.Exemplars, cfg)
+}
+
+func eqSummaryDataPoints[N int64 | float64](a, b metricdata.SummaryDataPoint[N], cfg config) bool {
+	if !eqAttributes(a.Attributes, b.Attributes, cfg) {
+		return false
+	}
+	if !cfg.ignoreTimestamp {
+		if !equalStartTimes(a.StartTime, b.StartTime, cfg) || !a.Time.Equal(b.Time) {
+			return false
+		}
+	}
+	if !cfg.ignoreValue {
+		if a.Count != b.Count || !equalValues(a.Sum, b.Sum, cfg) {
+			return false
+		}
+		if !eqExtrema(a.Min, b.Min, cfg) || !eqExtrema(a.Max, b.Max, cfg) {
+			return false
+		}
+	}
+	return true
+}
+
+func eqExponentialHistogramDataPoints[N int64 | float64](a, b metricdata.ExponentialHistogramDataPoint[N], cfg config) bool {
+	if !eqAttributes(a.Attributes, b.Attributes, cfg) {
+		return false
+	}
+	if !cfg.ignoreTimestamp {
+		if !equalStartTimes(a.StartTime, b.StartTime, cfg) || !a.Time.Equal(b.Time) {
+			return false
+		}
+	}
+	if !cfg.ignoreValue {
+		if a.Scale != b.Scale {
+			return false
+		}
+		if !cfg.normalizeBucketCounts && a.Count != b.Count {
+			return false
+		}
+		if !equalBucketCount(a.ZeroCount, a.Count, b.ZeroCount, b.Count, cfg) {
+			return false
+		}
+		if !cfg.ignoreHistogramMinMax && (!eqExtrema(a.Min, b.Min, cfg) || !eqExtrema(a.Max, b.Max, cfg)) {
+			return false
+		}
+		if !cfg.ignoreHistogramSum && !cfg.normalizeBucketCounts && !equalValues(a.Sum, b.Sum, cfg) {
+			return false
+		}
+		if !eqExponentialBuckets(a.PositiveBucket, a.Count, b.PositiveBucket, b.Count, cfg) ||
+			!eqExponentialBuckets(a.NegativeBucket, a.Count, b.NegativeBucket, b.Count, cfg) {
+			return false
+		}
+	}
+	return eqExemplarSlices(a.Exemplars, b.Exemplars, cfg)
+}
+
+func eqExponentialBuckets(a metricdata.ExponentialBucket, aTotal uint64, b metricdata.ExponentialBucket, bTotal uint64, cfg config) bool {
+	return a.Offset == b.Offset && equalBucketCounts(a.Counts, aTotal, b.Counts, bTotal, cfg)
+}
+
+func eqExemplarSlices[N int64 | float64](a, b []metricdata.Exemplar[N], cfg config) bool {
+	if cfg.ignoreExemplars {
+		return true
+	}
+	exCfg := cfg
+	exCfg.subset = cfg.subset || cfg.exemplarSubset
+	return matchSlices(exCfg, a, b, func(a, b metricdata.Exemplar[N]) bool {
+		return eqExemplars(a, b, cfg)
+	})
+}
+
+func eqExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config) bool {
+	if !cfg.ignoreExemplarFilteredAttributes && !eqKeyValues(a.FilteredAttributes, b.FilteredAttributes, cfg) {
+		return false
+	}
+	if !cfg.ignoreTimestamp && !a.Time.Equal(b.Time) {
+		return false
+	}
+	if !cfg.ignoreValue && !equalValues(a.Value, b.Value, cfg) {
+		return false
+	}
+	if cfg.requireNonZeroTraceContext {
+		return !isZeroID(b.SpanID) && !isZeroID(b.TraceID)
+	}
+	return equalSlices(a.SpanID, b.SpanID) && equalSlices(a.TraceID, b.TraceID)
+}
+
+// eqAttributes returns if the data point attributes a and b are equal based
+// on cfg.
+func eqAttributes(a, b attribute.Set, cfg config) bool {
+	if cfg.attributeFilter != nil {
+		a, _ = a.Filter(cfg.attributeFilter)
+		b, _ = b.Filter(cfg.attributeFilter)
+	}
+	return equalSets(a, b, cfg)
+}
+
+// eqKeyValues returns if a and b contain the same attributes, independent of
+// their order. Attributes stored in the same order are compared without
+// allocating.
+func eqKeyValues(a, b []attribute.KeyValue, cfg config) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	same := true
+	for i := range a {
+		if !equalKeyValue(a[i], b[i], cfg) {
+			same = false
+			break
+		}
+	}
+	if same {
+		return true
+	}
+	aSet, bSet := attribute.NewSet(a...), attribute.NewSet(b...)
+	return equalSets(aSet, bSet, cfg)
+}
+
+// matchSlices returns if every element of a is matched by an element of b,
+// and every element of b is matched by an element of a. Elements are matched
+// the same as diffSlices. It stops at the first element of a that is not
+// matched.
+//
+// If cfg is configured for a subset comparison, b is allowed to contain
+// additional elements.
+func matchSlices[T any](cfg config, a, b []T, equal func(T, T) bool) bool {
+	if len(a) > len(b) || (!cfg.subset && len(a) != len(b)) {
+		return false
+	}
+
+	// Avoid allocating for the common case of small slices.
+	var pairsBuf [64]int
+	var seenBuf [64]bool
+	var pairs []int
+	var seen []bool
+	if len(b) <= len(pairsBuf) {
+		pairs, seen = pairsBuf[:len(b)], seenBuf[:len(b)]
+	} else {
+		pairs, seen = make([]int, len(b)), make([]bool, len(b))
+	}
+	for j := range pairs {
+		pairs[j] = -1
+	}
+
+	for i := range a {
+		if !pairElement(a, b, i, equal, pairs, seen) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchPairs returns if all elements of a and b are paired by key and each
+// pair is equal. Elements are paired the same as pairSlices.
+func matchPairs[T any](cfg config, a, b []T, key func(T) string, equal func(T, T) bool) bool {
+	if len(a) > len(b) || (!cfg.subset && len(a) != len(b)) {
+		return false
+	}
+
+	visited := make([]bool, len(b))
+	for i := range a {
+		k := key(a[i])
+		found := false
+		for j := range b {
+			if visited[j] || key(b[j]) != k {
+				continue
+			}
+			if !equal(a[i], b[j]) {
+				return false
+			}
+			visited[j] = true
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// matchDataPoints returns if the data points of a and b are all matched by
+// each other. Data points are matched positionally if cfg is configured for
+// an ordered comparison, otherwise they are matched the same as matchSlices.
+func matchDataPoints[T any](cfg config, a, b []T, equal func(T, T) bool) bool {
+	if !cfg.orderedDataPoints {
+		return matchSlices(cfg, a, b, equal)
+	}
+	if len(a) > len(b) || (!cfg.subset && len(a) != len(b)) {
+		return false
+	}
+	for i := range a {
+		if !equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func testEqualFast[T Datatypes](a, b T) func(*testing.T) {
+	return func(t *testing.T) {
+		assert.True(t, EqualFast(a, a), "%T should be equal to itself", a)
+		assert.False(t, EqualFast(a, b), "%T values should not be equal", a)
+
+		equal, _ := Equal(a, b, IgnoreTimestamp())
+		assert.Equal(t, equal, EqualFast(a, b, IgnoreTimestamp()), "EqualFast and Equal disagree")
+	}
+}
+
+func TestEqualFast(t *testing.T) {
+	t.Run("ResourceMetrics", testEqualFast(resourceMetricsA, resourceMetricsB))
+	t.Run("ScopeMetrics", testEqualFast(scopeMetricsA, scopeMetricsB))
+	t.Run("Metrics", testEqualFast(metricsA, metricsB))
+	t.Run("HistogramInt64", testEqualFast(histogramInt64A, histogramInt64B))
+	t.Run("HistogramFloat64", testEqualFast(histogramFloat64A, histogramFloat64B))
+	t.Run("SumInt64", testEqualFast(sumInt64A, sumInt64B))
+	t.Run("SumFloat64", testEqualFast(sumFloat64A, sumFloat64B))
+	t.Run("GaugeInt64", testEqualFast(gaugeInt64A, gaugeInt64B))
+	t.Run("GaugeFloat64", testEqualFast(gaugeFloat64A, gaugeFloat64B))
+	t.Run("HistogramDataPointInt64", testEqualFast(histogramDataPointInt64A, histogramDataPointInt64B))
+	t.Run("HistogramDataPointFloat64", testEqualFast(histogramDataPointFloat64A, histogramDataPointFloat64B))
+	t.Run("DataPointInt64", testEqualFast(dataPointInt64A, dataPointInt64B))
+	t.Run("DataPointFloat64", testEqualFast(dataPointFloat64A, dataPointFloat64B))
+	t.Run("ExemplarInt64", testEqualFast(exemplarInt64A, exemplarInt64B))
+	t.Run("ExemplarFloat64", testEqualFast(exemplarFloat64A, exemplarFloat64B))
+	t.Run("ExtremaInt64", testEqualFast(minInt64A, minInt64B))
+	t.Run("ExtremaFloat64", testEqualFast(minFloat64A, minFloat64B))
+	t.Run("ExponentialHistogramInt64", testEqualFast(exponentialHistogramInt64A, exponentialHistogramInt64B))
+	t.Run("ExponentialHistogramFloat64", testEqualFast(exponentialHistogramFloat64A, exponentialHistogramFloat64B))
+	t.Run("ExponentialHistogramDataPointInt64", testEqualFast(exponentialHistogramDataPointInt64A, exponentialHistogramDataPointInt64B))
+	t.Run("ExponentialHistogramDataPointFloat64", testEqualFast(exponentialHistogramDataPointFloat64A, exponentialHistogramDataPointFloat64B))
+	t.Run("ExponentialBuckets", testEqualFast(exponentialBucket2, exponentialBucket3))
+}
+
+func TestEqualFastOptions(t *testing.T) {
+	assert.True(t, EqualFast(resourceMetricsA, resourceMetricsC, IgnoreTimestamp()))
+	assert.False(t, EqualFast(resourceMetricsA, resourceMetricsC))
+
+	sum := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B},
+	}
+	reversed := sum
+	reversed.DataPoints = []metricdata.DataPoint[int64]{dataPointInt64B, dataPointInt64A}
+	assert.True(t, EqualFast(sum, reversed))
+	assert.False(t, EqualFast(sum, reversed, orderedOption{}))
+
+	sub := sum
+	sub.DataPoints = sum.DataPoints[:1]
+	assert.False(t, EqualFast(sub, sum))
+	assert.True(t, EqualFast(sub, sum, subsetOption{}))
+	assert.False(t, EqualFast(sum, sub, subsetOption{}))
+
+	exA := metricdata.Exemplar[int64]{FilteredAttributes: []attribute.KeyValue{attribute.Bool("a", true), attribute.Bool("b", true)}}
+	exB := metricdata.Exemplar[int64]{FilteredAttributes: []attribute.KeyValue{attribute.Bool("b", true), attribute.Bool("a", true)}}
+	assert.True(t, EqualFast(exA, exB), "FilteredAttributes order should not matter")
+}
+
+// orderedOption and subsetOption set the internal modes of AssertEqualOrdered
+// and AssertContains.
+type (
+	orderedOption struct{}
+	subsetOption  struct{}
+)
+
+func (orderedOption) apply(cfg config) config {
+	cfg.orderedDataPoints = true
+	return cfg
+}
+
+func (subsetOption) apply(cfg config) config {
+	cfg.subset = true
+	return cfg
+}
+
+func TestEqualFastAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = EqualFast(allAggregationsResourceMetrics, allAggregationsResourceMetrics)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestEqualFastSummary(t *testing.T) {
+	dpA := metricdata.SummaryDataPoint[int64]{
+		Attributes: attrA,
+		StartTime:  startA,
+		Time:       endA,
+		Count:      2,
+		Min:        minInt64A,
+		Max:        maxInt64B,
+		Sum:        3,
+	}
+	dpB := dpA
+	dpB.Attributes = attrB
+	dpB.Sum = 4
+	summaryA := metricdata.Summary[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.SummaryDataPoint[int64]{dpA, dpB},
+	}
+	summaryB := metricdata.Summary[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.SummaryDataPoint[int64]{dpB},
+	}
+	metricsA := metricdata.Metrics{Name: "summary", Data: summaryA}
+	metricsB := metricdata.Metrics{Name: "summary", Data: summaryB}
+
+	t.Run("Summary", testEqualFast(summaryA, summaryB))
+	t.Run("SummaryDataPoint", testEqualFast(dpA, dpB))
+	t.Run("Metrics", testEqualFast(metricsA, metricsB))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = EqualFast(metricsA, metricsA)
+	})
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkEqualFast(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_ = EqualFast(allAggregationsResourceMetrics, allAggregationsResourceMetrics)
+	}
+}
+
+func BenchmarkEqual(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, _ = Equal(allAggregationsResourceMetrics, allAggregationsResourceMetrics)
+	}
+}
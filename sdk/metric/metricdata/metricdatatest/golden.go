@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// updateEnv is the environment variable that, if set to true, will cause
+// golden files to be updated.
+const updateEnv = "METRICDATATEST_UPDATE_GOLDEN"
+
+// AssertGolden asserts that actual is equal to the ResourceMetrics stored in
+// the golden file at goldenPath.
+//
+// The golden file contains a canonical JSON encoding of the ResourceMetrics.
+// It is written from actual, and the assertion passes, when the golden files
+// are updated. Otherwise the assertion fails if the golden file does not
+// exist. Golden files are updated when the WithGoldenUpdate option is passed
+// with true, or when the test is run with the METRICDATATEST_UPDATE_GOLDEN
+// environment variable set to true, e.g.
+// METRICDATATEST_UPDATE_GOLDEN=true go test ./...
+//
+// The comparison is the same as AssertEqual and honors the passed opts.
+func AssertGolden(t TestingT, actual metricdata.ResourceMetrics, goldenPath string, opts ...Option) bool {
+	t.Helper()
+
+	if newConfig(opts).updateGolden || updateGoldenEnv() {
+		if err := writeGolden(goldenPath, actual); err != nil {
+			t.Error(err)
+			return false
+		}
+		return true
+	}
+
+	data, err := os.ReadFile(goldenPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Error(fmt.Sprintf("golden file %s does not exist, run the test with %s=true to create it", goldenPath, updateEnv))
+		return false
+	}
+	if err != nil {
+		t.Error(fmt.Sprintf("failed to read golden file: %v", err))
+		return false
+	}
+
+	expected, err := unmarshalJSON(data)
+	if err != nil {
+		t.Error(fmt.Sprintf("failed to decode golden file %s: %v", goldenPath, err))
+		return false
+	}
+	return AssertEqual(t, expected, actual, opts...)
+}
+
+// WithGoldenUpdate returns an option that makes AssertGolden write its golden
+// file from the actual value instead of comparing against it if update is
+// true. It is a hook for the update flag of a test binary, metricdatatest
+// does not define any flag itself, e.g.
+//
+//	var update = flag.Bool("update", false, "update golden files")
+//
+//	metricdatatest.AssertGolden(t, rm, path, metricdatatest.WithGoldenUpdate(*update))
+//
+// This option is ignored by all other assertions.
+func WithGoldenUpdate(update bool) Option {
+	return fnOption(func(cfg config) config {
+		cfg.updateGolden = update
+		return cfg
+	})
+}
+
+func updateGoldenEnv() bool {
+	update, _ := strconv.ParseBool(os.Getenv(updateEnv))
+	return update
+}
+
+func writeGolden(path string, rm metricdata.ResourceMetrics) error {
+	data, err := marshalJSON(rm)
+	if err != nil {
+		return fmt.Errorf("failed to encode golden file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create golden file directory: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write golden file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "golden.json")
+	require.True(t, AssertGolden(t, allAggregationsResourceMetrics, path, WithGoldenUpdate(true)))
+	_, err := os.Stat(path)
+	require.NoError(t, err)
+
+	assert.True(t, AssertGolden(t, allAggregationsResourceMetrics, path))
+	assert.False(t, AssertGolden(&testing.T{}, resourceMetricsB, path))
+
+	// Options are honored.
+	path = filepath.Join(t.TempDir(), "golden.json")
+	require.True(t, AssertGolden(t, resourceMetricsA, path, WithGoldenUpdate(true)))
+	assert.False(t, AssertGolden(&testing.T{}, resourceMetricsC, path))
+	assert.True(t, AssertGolden(t, resourceMetricsC, path, IgnoreTimestamp()))
+}
+
+func TestAssertGoldenMissing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden.json")
+	assert.False(t, AssertGolden(&testing.T{}, resourceMetricsA, path))
+	assert.False(t, AssertGolden(&testing.T{}, resourceMetricsA, path, WithGoldenUpdate(false)))
+	_, err := os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist, "missing golden file created")
+}
+
+func TestAssertGoldenUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden.json")
+	require.True(t, AssertGolden(t, resourceMetricsA, path, WithGoldenUpdate(true)))
+
+	assert.True(t, AssertGolden(t, resourceMetricsB, path, WithGoldenUpdate(true)))
+	assert.True(t, AssertGolden(t, resourceMetricsB, path))
+	assert.False(t, AssertGolden(&testing.T{}, resourceMetricsA, path))
+}
+
+func TestAssertGoldenUpdateEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden.json")
+	t.Setenv(updateEnv, "true")
+	assert.True(t, AssertGolden(t, resourceMetricsA, path))
+	assert.True(t, AssertGolden(t, resourceMetricsB, path))
+
+	t.Setenv(updateEnv, "false")
+	assert.True(t, AssertGolden(t, resourceMetricsB, path))
+	assert.False(t, AssertGolden(&testing.T{}, resourceMetricsA, path))
+}
+
+func TestAssertGoldenInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden.json")
+	require.NoError(t, os.WriteFile(path, []byte("invalid"), 0o600))
+	assert.False(t, AssertGolden(&testing.T{}, resourceMetricsA, path))
+}
@@ -0,0 +1,755 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// The types in this file define a canonical JSON encoding of the metricdata
+// types. The encoding is deterministic: attributes are sorted by key and
+// timestamps are encoded in RFC 3339 format in UTC. The concrete type of each
+// Aggregation is tagged so it can be decoded.
+
+var (
+	errUnknownAggregation = errors.New("unknown aggregation")
+	errUnsupportedType    = errors.New("unsupported type")
+)
+
+// Marshal returns the canonical JSON encoding of v. The value v needs to be a
+// metricdata.ResourceMetrics, a pointer to one, or one of the Aggregations
+// of the metricdata package.
+//
+// The encoding is deterministic and tags the concrete type of each
+// Aggregation so it can be decoded with Unmarshal or UnmarshalAggregation.
+// This can be used to create test fixtures from the actual value of a failed
+// assertion.
+func Marshal(v any) ([]byte, error) {
+	switch rm := v.(type) {
+	case metricdata.ResourceMetrics:
+		return marshalJSON(rm)
+	case *metricdata.ResourceMetrics:
+		if rm == nil {
+			return marshalJSON(metricdata.ResourceMetrics{})
+		}
+		return marshalJSON(*rm)
+	case metricdata.Aggregation:
+		enc, err := encodeAggregation(rm)
+		if errors.Is(err, errUnknownAggregation) {
+			return nil, fmt.Errorf("%w: %T", errUnsupportedType, v)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return json.MarshalIndent(enc, "", "\t")
+	}
+	return nil, fmt.Errorf("%w: %T", errUnsupportedType, v)
+}
+
+// Unmarshal decodes the JSON encoding of a metricdata.ResourceMetrics
+// produced by Marshal. The decoded value is equal to the value that was
+// encoded.
+func Unmarshal(data []byte) (metricdata.ResourceMetrics, error) {
+	return unmarshalJSON(data)
+}
+
+// UnmarshalAggregation decodes the JSON encoding of an Aggregation produced
+// by Marshal. The concrete type of the returned Aggregation is the type that
+// was encoded.
+func UnmarshalAggregation(data []byte) (metricdata.Aggregation, error) {
+	var enc *jsonAggregation
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return nil, err
+	}
+	return decodeAggregation(enc)
+}
+
+type jsonResourceMetrics struct {
+	Resource     *jsonResource
+	ScopeMetrics []jsonScopeMetrics
+}
+
+type jsonResource struct {
+	SchemaURL  string `json:",omitempty"`
+	Attributes []jsonKeyValue
+}
+
+type jsonScopeMetrics struct {
+	Scope   instrumentation.Scope
+	Metrics []jsonMetrics
+}
+
+type jsonMetrics struct {
+	Name        string
+	Description string `json:",omitempty"`
+	Unit        string `json:",omitempty"`
+	Data        *jsonAggregation
+}
+
+// jsonAggregation is the tagged encoding of a metricdata.Aggregation.
+type jsonAggregation struct {
+	// Type is the name of the aggregation type (e.g. "Sum").
+	Type string
+	// Number is the numeric type parameter of the aggregation (e.g. "int64").
+	Number string
+	// Aggregation holds type specific encoding of the aggregation.
+	Aggregation json.RawMessage
+}
+
+type jsonGauge[N int64 | float64] struct {
+	DataPoints []jsonDataPoint[N]
+}
+
+type jsonSum[N int64 | float64] struct {
+	Temporality string
+	IsMonotonic bool
+	DataPoints  []jsonDataPoint[N]
+}
+
+type jsonHistogram[N int64 | float64] struct {
+	Temporality string
+	DataPoints  []jsonHistogramDataPoint[N]
+}
+
+type jsonExponentialHistogram[N int64 | float64] struct {
+	Temporality string
+	DataPoints  []jsonExponentialHistogramDataPoint[N]
+}
+
+type jsonDataPoint[N int64 | float64] struct {
+	Attributes []jsonKeyValue
+	StartTime  string `json:",omitempty"`
+	Time       string `json:",omitempty"`
+	Value      N
+	Exemplars  []jsonExemplar[N] `json:",omitempty"`
+}
+
+type jsonHistogramDataPoint[N int64 | float64] struct {
+	Attributes   []jsonKeyValue
+	StartTime    string `json:",omitempty"`
+	Time         string `json:",omitempty"`
+	Count        uint64
+	Bounds       []float64
+	BucketCounts []uint64
+	Min          *N `json:",omitempty"`
+	Max          *N `json:",omitempty"`
+	Sum          N
+	Exemplars    []jsonExemplar[N] `json:",omitempty"`
+}
+
+type jsonExponentialHistogramDataPoint[N int64 | float64] struct {
+	Attributes     []jsonKeyValue
+	StartTime      string `json:",omitempty"`
+	Time           string `json:",omitempty"`
+	Count          uint64
+	Min            *N `json:",omitempty"`
+	Max            *N `json:",omitempty"`
+	Sum            N
+	Scale          int32
+	ZeroCount      uint64
+	PositiveBucket metricdata.ExponentialBucket
+	NegativeBucket metricdata.ExponentialBucket
+	ZeroThreshold  float64
+	Exemplars      []jsonExemplar[N] `json:",omitempty"`
+}
+
+type jsonExemplar[N int64 | float64] struct {
+	FilteredAttributes []jsonKeyValue `json:",omitempty"`
+	Time               string         `json:",omitempty"`
+	Value              N
+	SpanID             string `json:",omitempty"`
+	TraceID            string `json:",omitempty"`
+}
+
+type jsonKeyValue struct {
+	Key   string
+	Type  string
+	Value json.RawMessage
+}
+
+func marshalJSON(rm metricdata.ResourceMetrics) ([]byte, error) {
+	enc, err := encodeResourceMetrics(rm)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(enc, "", "\t")
+}
+
+func unmarshalJSON(data []byte) (metricdata.ResourceMetrics, error) {
+	var enc jsonResourceMetrics
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return metricdata.ResourceMetrics{}, err
+	}
+	return decodeResourceMetrics(enc)
+}
+
+func encodeResourceMetrics(rm metricdata.ResourceMetrics) (jsonResourceMetrics, error) {
+	var out jsonResourceMetrics
+	if rm.Resource != nil {
+		attrs, err := encodeKeyValues(rm.Resource.Attributes())
+		if err != nil {
+			return out, err
+		}
+		out.Resource = &jsonResource{
+			SchemaURL:  rm.Resource.SchemaURL(),
+			Attributes: attrs,
+		}
+	}
+	for _, sm := range rm.ScopeMetrics {
+		jsm := jsonScopeMetrics{Scope: sm.Scope}
+		for _, m := range sm.Metrics {
+			data, err := encodeAggregation(m.Data)
+			if err != nil {
+				return out, fmt.Errorf("metric %s: %w", m.Name, err)
+			}
+			jsm.Metrics = append(jsm.Metrics, jsonMetrics{
+				Name:        m.Name,
+				Description: m.Description,
+				Unit:        m.Unit,
+				Data:        data,
+			})
+		}
+		out.ScopeMetrics = append(out.ScopeMetrics, jsm)
+	}
+	return out, nil
+}
+
+func decodeResourceMetrics(enc jsonResourceMetrics) (metricdata.ResourceMetrics, error) {
+	var out metricdata.ResourceMetrics
+	if enc.Resource != nil {
+		attrs, err := decodeKeyValues(enc.Resource.Attributes)
+		if err != nil {
+			return out, err
+		}
+		out.Resource = resource.NewWithAttributes(enc.Resource.SchemaURL, attrs...)
+	}
+	for _, jsm := range enc.ScopeMetrics {
+		sm := metricdata.ScopeMetrics{Scope: jsm.Scope}
+		for _, jm := range jsm.Metrics {
+			data, err := decodeAggregation(jm.Data)
+			if err != nil {
+				return out, fmt.Errorf("metric %s: %w", jm.Name, err)
+			}
+			sm.Metrics = append(sm.Metrics, metricdata.Metrics{
+				Name:        jm.Name,
+				Description: jm.Description,
+				Unit:        jm.Unit,
+				Data:        data,
+			})
+		}
+		out.ScopeMetrics = append(out.ScopeMetrics, sm)
+	}
+	return out, nil
+}
+
+func encodeAggregation(agg metricdata.Aggregation) (*jsonAggregation, error) {
+	var (
+		typ, num string
+		v        any
+		err      error
+	)
+	switch a := agg.(type) {
+	case nil:
+		return nil, nil
+	case metricdata.Gauge[int64]:
+		typ, num = "Gauge", "int64"
+		v, err = encodeGauge(a)
+	case metricdata.Gauge[float64]:
+		typ, num = "Gauge", "float64"
+		v, err = encodeGauge(a)
+	case metricdata.Sum[int64]:
+		typ, num = "Sum", "int64"
+		v, err = encodeSum(a)
+	case metricdata.Sum[float64]:
+		typ, num = "Sum", "float64"
+		v, err = encodeSum(a)
+	case metricdata.Histogram[int64]:
+		typ, num = "Histogram", "int64"
+		v, err = encodeHistogram(a)
+	case metricdata.Histogram[float64]:
+		typ, num = "Histogram", "float64"
+		v, err = encodeHistogram(a)
+	case metricdata.ExponentialHistogram[int64]:
+		typ, num = "ExponentialHistogram", "int64"
+		v, err = encodeExponentialHistogram(a)
+	case metricdata.ExponentialHistogram[float64]:
+		typ, num = "ExponentialHistogram", "float64"
+		v, err = encodeExponentialHistogram(a)
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnknownAggregation, agg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonAggregation{Type: typ, Number: num, Aggregation: raw}, nil
+}
+
+func decodeAggregation(enc *jsonAggregation) (metricdata.Aggregation, error) {
+	if enc == nil {
+		return nil, nil
+	}
+
+	switch enc.Type + "[" + enc.Number + "]" {
+	case "Gauge[int64]":
+		return decodeGauge[int64](enc.Aggregation)
+	case "Gauge[float64]":
+		return decodeGauge[float64](enc.Aggregation)
+	case "Sum[int64]":
+		return decodeSum[int64](enc.Aggregation)
+	case "Sum[float64]":
+		return decodeSum[float64](enc.Aggregation)
+	case "Histogram[int64]":
+		return decodeHistogram[int64](enc.Aggregation)
+	case "Histogram[float64]":
+		return decodeHistogram[float64](enc.Aggregation)
+	case "ExponentialHistogram[int64]":
+		return decodeExponentialHistogram[int64](enc.Aggregation)
+	case "ExponentialHistogram[float64]":
+		return decodeExponentialHistogram[float64](enc.Aggregation)
+	}
+	return nil, fmt.Errorf("%w: %s[%s]", errUnknownAggregation, enc.Type, enc.Number)
+}
+
+func encodeGauge[N int64 | float64](g metricdata.Gauge[N]) (jsonGauge[N], error) {
+	dps, err := encodeDataPoints(g.DataPoints)
+	return jsonGauge[N]{DataPoints: dps}, err
+}
+
+func decodeGauge[N int64 | float64](data []byte) (metricdata.Gauge[N], error) {
+	var enc jsonGauge[N]
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return metricdata.Gauge[N]{}, err
+	}
+	dps, err := decodeDataPoints(enc.DataPoints)
+	return metricdata.Gauge[N]{DataPoints: dps}, err
+}
+
+func encodeSum[N int64 | float64](s metricdata.Sum[N]) (jsonSum[N], error) {
+	dps, err := encodeDataPoints(s.DataPoints)
+	return jsonSum[N]{
+		Temporality: s.Temporality.String(),
+		IsMonotonic: s.IsMonotonic,
+		DataPoints:  dps,
+	}, err
+}
+
+func decodeSum[N int64 | float64](data []byte) (metricdata.Sum[N], error) {
+	var enc jsonSum[N]
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return metricdata.Sum[N]{}, err
+	}
+	t, err := decodeTemporality(enc.Temporality)
+	if err != nil {
+		return metricdata.Sum[N]{}, err
+	}
+	dps, err := decodeDataPoints(enc.DataPoints)
+	return metricdata.Sum[N]{
+		Temporality: t,
+		IsMonotonic: enc.IsMonotonic,
+		DataPoints:  dps,
+	}, err
+}
+
+func encodeHistogram[N int64 | float64](h metricdata.Histogram[N]) (jsonHistogram[N], error) {
+	out := jsonHistogram[N]{Temporality: h.Temporality.String()}
+	for _, dp := range h.DataPoints {
+		attrs, err := encodeKeyValues(dp.Attributes.ToSlice())
+		if err != nil {
+			return out, err
+		}
+		exemplars, err := encodeExemplars(dp.Exemplars)
+		if err != nil {
+			return out, err
+		}
+		out.DataPoints = append(out.DataPoints, jsonHistogramDataPoint[N]{
+			Attributes:   attrs,
+			StartTime:    encodeTime(dp.StartTime),
+			Time:         encodeTime(dp.Time),
+			Count:        dp.Count,
+			Bounds:       dp.Bounds,
+			BucketCounts: dp.BucketCounts,
+			Min:          encodeExtrema(dp.Min),
+			Max:          encodeExtrema(dp.Max),
+			Sum:          dp.Sum,
+			Exemplars:    exemplars,
+		})
+	}
+	return out, nil
+}
+
+func decodeHistogram[N int64 | float64](data []byte) (metricdata.Histogram[N], error) {
+	var enc jsonHistogram[N]
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return metricdata.Histogram[N]{}, err
+	}
+	t, err := decodeTemporality(enc.Temporality)
+	if err != nil {
+		return metricdata.Histogram[N]{}, err
+	}
+	out := metricdata.Histogram[N]{Temporality: t}
+	for _, jdp := range enc.DataPoints {
+		attrs, err := decodeKeyValues(jdp.Attributes)
+		if err != nil {
+			return out, err
+		}
+		start, err := decodeTime(jdp.StartTime)
+		if err != nil {
+			return out, err
+		}
+		end, err := decodeTime(jdp.Time)
+		if err != nil {
+			return out, err
+		}
+		exemplars, err := decodeExemplars(jdp.Exemplars)
+		if err != nil {
+			return out, err
+		}
+		out.DataPoints = append(out.DataPoints, metricdata.HistogramDataPoint[N]{
+			Attributes:   attribute.NewSet(attrs...),
+			StartTime:    start,
+			Time:         end,
+			Count:        jdp.Count,
+			Bounds:       jdp.Bounds,
+			BucketCounts: jdp.BucketCounts,
+			Min:          decodeExtrema(jdp.Min),
+			Max:          decodeExtrema(jdp.Max),
+			Sum:          jdp.Sum,
+			Exemplars:    exemplars,
+		})
+	}
+	return out, nil
+}
+
+func encodeExponentialHistogram[N int64 | float64](h metricdata.ExponentialHistogram[N]) (jsonExponentialHistogram[N], error) {
+	out := jsonExponentialHistogram[N]{Temporality: h.Temporality.String()}
+	for _, dp := range h.DataPoints {
+		attrs, err := encodeKeyValues(dp.Attributes.ToSlice())
+		if err != nil {
+			return out, err
+		}
+		exemplars, err := encodeExemplars(dp.Exemplars)
+		if err != nil {
+			return out, err
+		}
+		out.DataPoints = append(out.DataPoints, jsonExponentialHistogramDataPoint[N]{
+			Attributes:     attrs,
+			StartTime:      encodeTime(dp.StartTime),
+			Time:           encodeTime(dp.Time),
+			Count:          dp.Count,
+			Min:            encodeExtrema(dp.Min),
+			Max:            encodeExtrema(dp.Max),
+			Sum:            dp.Sum,
+			Scale:          dp.Scale,
+			ZeroCount:      dp.ZeroCount,
+			PositiveBucket: dp.PositiveBucket,
+			NegativeBucket: dp.NegativeBucket,
+			ZeroThreshold:  dp.ZeroThreshold,
+			Exemplars:      exemplars,
+		})
+	}
+	return out, nil
+}
+
+func decodeExponentialHistogram[N int64 | float64](data []byte) (metricdata.ExponentialHistogram[N], error) {
+	var enc jsonExponentialHistogram[N]
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return metricdata.ExponentialHistogram[N]{}, err
+	}
+	t, err := decodeTemporality(enc.Temporality)
+	if err != nil {
+		return metricdata.ExponentialHistogram[N]{}, err
+	}
+	out := metricdata.ExponentialHistogram[N]{Temporality: t}
+	for _, jdp := range enc.DataPoints {
+		attrs, err := decodeKeyValues(jdp.Attributes)
+		if err != nil {
+			return out, err
+		}
+		start, err := decodeTime(jdp.StartTime)
+		if err != nil {
+			return out, err
+		}
+		end, err := decodeTime(jdp.Time)
+		if err != nil {
+			return out, err
+		}
+		exemplars, err := decodeExemplars(jdp.Exemplars)
+		if err != nil {
+			return out, err
+		}
+		out.DataPoints = append(out.DataPoints, metricdata.ExponentialHistogramDataPoint[N]{
+			Attributes:     attribute.NewSet(attrs...),
+			StartTime:      start,
+			Time:           end,
+			Count:          jdp.Count,
+			Min:            decodeExtrema(jdp.Min),
+			Max:            decodeExtrema(jdp.Max),
+			Sum:            jdp.Sum,
+			Scale:          jdp.Scale,
+			ZeroCount:      jdp.ZeroCount,
+			PositiveBucket: jdp.PositiveBucket,
+			NegativeBucket: jdp.NegativeBucket,
+			ZeroThreshold:  jdp.ZeroThreshold,
+			Exemplars:      exemplars,
+		})
+	}
+	return out, nil
+}
+
+func encodeDataPoints[N int64 | float64](dps []metricdata.DataPoint[N]) ([]jsonDataPoint[N], error) {
+	var out []jsonDataPoint[N]
+	for _, dp := range dps {
+		attrs, err := encodeKeyValues(dp.Attributes.ToSlice())
+		if err != nil {
+			return nil, err
+		}
+		exemplars, err := encodeExemplars(dp.Exemplars)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, jsonDataPoint[N]{
+			Attributes: attrs,
+			StartTime:  encodeTime(dp.StartTime),
+			Time:       encodeTime(dp.Time),
+			Value:      dp.Value,
+			Exemplars:  exemplars,
+		})
+	}
+	return out, nil
+}
+
+func decodeDataPoints[N int64 | float64](enc []jsonDataPoint[N]) ([]metricdata.DataPoint[N], error) {
+	var out []metricdata.DataPoint[N]
+	for _, jdp := range enc {
+		attrs, err := decodeKeyValues(jdp.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		start, err := decodeTime(jdp.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		end, err := decodeTime(jdp.Time)
+		if err != nil {
+			return nil, err
+		}
+		exemplars, err := decodeExemplars(jdp.Exemplars)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, metricdata.DataPoint[N]{
+			Attributes: attribute.NewSet(attrs...),
+			StartTime:  start,
+			Time:       end,
+			Value:      jdp.Value,
+			Exemplars:  exemplars,
+		})
+	}
+	return out, nil
+}
+
+func encodeExemplars[N int64 | float64](exemplars []metricdata.Exemplar[N]) ([]jsonExemplar[N], error) {
+	var out []jsonExemplar[N]
+	for _, e := range exemplars {
+		attrs, err := encodeKeyValues(e.FilteredAttributes)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, jsonExemplar[N]{
+			FilteredAttributes: attrs,
+			Time:               encodeTime(e.Time),
+			Value:              e.Value,
+			SpanID:             hex.EncodeToString(e.SpanID),
+			TraceID:            hex.EncodeToString(e.TraceID),
+		})
+	}
+	return out, nil
+}
+
+func decodeExemplars[N int64 | float64](enc []jsonExemplar[N]) ([]metricdata.Exemplar[N], error) {
+	var out []metricdata.Exemplar[N]
+	for _, je := range enc {
+		attrs, err := decodeKeyValues(je.FilteredAttributes)
+		if err != nil {
+			return nil, err
+		}
+		t, err := decodeTime(je.Time)
+		if err != nil {
+			return nil, err
+		}
+		spanID, err := decodeID(je.SpanID)
+		if err != nil {
+			return nil, err
+		}
+		traceID, err := decodeID(je.TraceID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, metricdata.Exemplar[N]{
+			FilteredAttributes: attrs,
+			Time:               t,
+			Value:              je.Value,
+			SpanID:             spanID,
+			TraceID:            traceID,
+		})
+	}
+	return out, nil
+}
+
+func decodeID(s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return hex.DecodeString(s)
+}
+
+func encodeExtrema[N int64 | float64](e metricdata.Extrema[N]) *N {
+	v, ok := e.Value()
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+func decodeExtrema[N int64 | float64](v *N) metricdata.Extrema[N] {
+	if v == nil {
+		return metricdata.Extrema[N]{}
+	}
+	return metricdata.NewExtrema(*v)
+}
+
+func encodeTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func decodeTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+func decodeTemporality(s string) (metricdata.Temporality, error) {
+	for _, t := range []metricdata.Temporality{
+		metricdata.CumulativeTemporality,
+		metricdata.DeltaTemporality,
+	} {
+		if t.String() == s {
+			return t, nil
+		}
+	}
+	if s == "" || s == metricdata.Temporality(0).String() {
+		return metricdata.Temporality(0), nil
+	}
+	return 0, fmt.Errorf("unknown temporality: %q", s)
+}
+
+// encodeKeyValues encodes kvs sorted by key.
+func encodeKeyValues(kvs []attribute.KeyValue) ([]jsonKeyValue, error) {
+	if len(kvs) == 0 {
+		return nil, nil
+	}
+	sorted := make([]attribute.KeyValue, len(kvs))
+	copy(sorted, kvs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Key < sorted[j].Key
+	})
+
+	out := make([]jsonKeyValue, len(sorted))
+	for i, kv := range sorted {
+		raw, err := json.Marshal(kv.Value.AsInterface())
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", kv.Key, err)
+		}
+		out[i] = jsonKeyValue{
+			Key:   string(kv.Key),
+			Type:  kv.Value.Type().String(),
+			Value: raw,
+		}
+	}
+	return out, nil
+}
+
+func decodeKeyValues(enc []jsonKeyValue) ([]attribute.KeyValue, error) {
+	if len(enc) == 0 {
+		return nil, nil
+	}
+	out := make([]attribute.KeyValue, len(enc))
+	for i, jkv := range enc {
+		v, err := decodeValue(jkv.Type, jkv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", jkv.Key, err)
+		}
+		out[i] = attribute.KeyValue{Key: attribute.Key(jkv.Key), Value: v}
+	}
+	return out, nil
+}
+
+func decodeValue(typ string, raw json.RawMessage) (attribute.Value, error) {
+	var err error
+	switch typ {
+	case attribute.BOOL.String():
+		var v bool
+		err = json.Unmarshal(raw, &v)
+		return attribute.BoolValue(v), err
+	case attribute.INT64.String():
+		var v int64
+		err = json.Unmarshal(raw, &v)
+		return attribute.Int64Value(v), err
+	case attribute.FLOAT64.String():
+		var v float64
+		err = json.Unmarshal(raw, &v)
+		return attribute.Float64Value(v), err
+	case attribute.STRING.String():
+		var v string
+		err = json.Unmarshal(raw, &v)
+		return attribute.StringValue(v), err
+	case attribute.BOOLSLICE.String():
+		var v []bool
+		err = json.Unmarshal(raw, &v)
+		return attribute.BoolSliceValue(v), err
+	case attribute.INT64SLICE.String():
+		var v []int64
+		err = json.Unmarshal(raw, &v)
+		return attribute.Int64SliceValue(v), err
+	case attribute.FLOAT64SLICE.String():
+		var v []float64
+		err = json.Unmarshal(raw, &v)
+		return attribute.Float64SliceValue(v), err
+	case attribute.STRINGSLICE.String():
+		var v []string
+		err = json.Unmarshal(raw, &v)
+		return attribute.StringSliceValue(v), err
+	}
+	return attribute.Value{}, fmt.Errorf("unknown attribute type: %q", typ)
+}
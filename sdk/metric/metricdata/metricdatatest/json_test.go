@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// allAggregationsResourceMetrics contains every Aggregation type.
+var allAggregationsResourceMetrics = metricdata.ResourceMetrics{
+	Resource: resource.NewWithAttributes(
+		"https://example.com/schema",
+		attribute.Bool("bool", true),
+		attribute.Int64("int", 1),
+		attribute.Float64("float", 1.5),
+		attribute.String("string", "s"),
+		attribute.BoolSlice("bools", []bool{true, false}),
+		attribute.Int64Slice("ints", []int64{1, 2}),
+		attribute.Float64Slice("floats", []float64{1.5, 2.5}),
+		attribute.StringSlice("strings", []string{"a", "b"}),
+	),
+	ScopeMetrics: []metricdata.ScopeMetrics{{
+		Scope: instrumentation.Scope{Name: "A", Version: "v1", SchemaURL: "https://example.com/scope"},
+		Metrics: []metricdata.Metrics{
+			{Name: "gaugeInt64", Data: gaugeInt64A},
+			{Name: "gaugeFloat64", Data: gaugeFloat64A},
+			{Name: "sumInt64", Description: "desc", Unit: "1", Data: sumInt64A},
+			{Name: "sumFloat64", Data: sumFloat64B},
+			{Name: "histogramInt64", Data: histogramInt64A},
+			{Name: "histogramFloat64", Data: histogramFloat64B},
+			{Name: "exponentialHistogramInt64", Data: exponentialHistogramInt64A},
+			{Name: "exponentialHistogramFloat64", Data: exponentialHistogramFloat64B},
+			{Name: "empty"},
+		},
+	}, scopeMetricsB},
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, rm := range []metricdata.ResourceMetrics{
+		{},
+		resourceMetricsA,
+		resourceMetricsB,
+		allAggregationsResourceMetrics,
+	} {
+		data, err := marshalJSON(rm)
+		require.NoError(t, err)
+
+		got, err := unmarshalJSON(data)
+		require.NoError(t, err)
+		AssertEqual(t, rm, got)
+
+		again, err := marshalJSON(got)
+		require.NoError(t, err)
+		assert.Equal(t, string(data), string(again), "encoding not canonical")
+	}
+}
+
+func TestJSONUnknownAggregation(t *testing.T) {
+	rm := metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Metrics: []metricdata.Metrics{{Name: "unknown", Data: unknownAggregation{}}},
+		}},
+	}
+	_, err := marshalJSON(rm)
+	assert.ErrorIs(t, err, errUnknownAggregation)
+
+	data := []byte(`{"ScopeMetrics":[{"Metrics":[{"Name":"unknown","Data":{"Type":"Summary","Number":"int64"}}]}]}`)
+	_, err = unmarshalJSON(data)
+	assert.ErrorIs(t, err, errUnknownAggregation)
+}
+
+func TestJSONInvalid(t *testing.T) {
+	_, err := unmarshalJSON([]byte(`{`))
+	assert.Error(t, err)
+
+	data := []byte(`{"Resource":{"Attributes":[{"Key":"a","Type":"INVALID","Value":1}]}}`)
+	_, err = unmarshalJSON(data)
+	assert.Error(t, err)
+
+	data = []byte(`{"ScopeMetrics":[{"Metrics":[{"Name":"sum","Data":{"Type":"Sum","Number":"int64","Aggregation":{"Temporality":"Invalid"}}}]}]}`)
+	_, err = unmarshalJSON(data)
+	assert.Error(t, err)
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	data, err := Marshal(allAggregationsResourceMetrics)
+	require.NoError(t, err)
+
+	got, err := Unmarshal(data)
+	require.NoError(t, err)
+	AssertEqual(t, allAggregationsResourceMetrics, got)
+
+	ptrData, err := Marshal(&allAggregationsResourceMetrics)
+	require.NoError(t, err)
+	assert.Equal(t, string(data), string(ptrData))
+
+	_, err = Marshal(metricsA)
+	assert.ErrorIs(t, err, errUnsupportedType)
+
+	_, err = Unmarshal([]byte(`{`))
+	assert.Error(t, err)
+}
+
+func TestMarshalUnmarshalAggregation(t *testing.T) {
+	for _, agg := range []metricdata.Aggregation{
+		gaugeInt64A,
+		gaugeFloat64A,
+		sumInt64A,
+		sumFloat64B,
+		histogramInt64A,
+		histogramFloat64B,
+		exponentialHistogramInt64A,
+		exponentialHistogramFloat64B,
+	} {
+		data, err := Marshal(agg)
+		require.NoError(t, err)
+
+		got, err := UnmarshalAggregation(data)
+		require.NoError(t, err)
+		AssertAggregationsEqual(t, agg, got)
+	}
+
+	_, err := Marshal(unknownAggregation{})
+	assert.ErrorIs(t, err, errUnsupportedType)
+
+	_, err = UnmarshalAggregation([]byte(`{"Type":"Summary","Number":"int64"}`))
+	assert.ErrorIs(t, err, errUnknownAggregation)
+
+	_, err = UnmarshalAggregation([]byte(`{`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Matcher asserts the concrete data-types from the metricdata package using
+// the same Options for every assertion. A Matcher can be defined once and
+// shared by all tests that need to apply the same comparison policy.
+//
+// Go methods cannot have type parameters, therefore the methods of Matcher
+// accept any value. The values passed need to be one of the Datatypes,
+// otherwise the assertion fails.
+type Matcher struct {
+	cfg config
+}
+
+// NewMatcher returns a Matcher that asserts with opts.
+func NewMatcher(opts ...Option) Matcher {
+	return Matcher{cfg: newConfig(opts)}
+}
+
+// Equal asserts that expected and actual are equal. The comparison is the
+// same as AssertEqual with the Options of m.
+func (m Matcher) Equal(t TestingT, expected, actual interface{}) bool {
+	t.Helper()
+
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		t.Error([]string{fmt.Sprintf("types not equal:\nexpected: %T\nactual: %T", expected, actual)})
+		return false
+	}
+	r, ok := equalIfaces(expected, actual, m.cfg)
+	if !ok {
+		t.Error([]string{fmt.Sprintf("unsupported type: %T", expected)})
+		return false
+	}
+	if len(r) > 0 {
+		t.Error(m.cfg.failure(expected, actual, r))
+		return false
+	}
+	return true
+}
+
+// HasAttributes asserts that all Datapoints or HistogramDataPoints of actual
+// have all passed attrs. The assertion is the same as AssertHasAttributes.
+func (m Matcher) HasAttributes(t TestingT, actual interface{}, attrs ...attribute.KeyValue) bool {
+	t.Helper()
+
+	r, ok := hasAttributes(actual, attrs...)
+	if !ok {
+		t.Error([]string{fmt.Sprintf("unsupported type: %T", actual)})
+		return false
+	}
+	if len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestMatcherEqual(t *testing.T) {
+	m := NewMatcher(IgnoreTimestamp())
+	m.Equal(t, resourceMetricsA, resourceMetricsC)
+	m.Equal(t, sumInt64A, sumInt64C)
+
+	rt := new(recordingT)
+	assert.False(t, NewMatcher().Equal(rt, sumInt64A, sumInt64C))
+	require.Len(t, rt.errors, 1)
+	assert.Equal(t, []any{equalSums(sumInt64A, sumInt64C, config{})}, rt.errors[0])
+
+	rt = new(recordingT)
+	assert.False(t, m.Equal(rt, sumInt64A, sumFloat64A))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0][0], "types not equal:\nexpected: metricdata.Sum[int64]\nactual: metricdata.Sum[float64]")
+
+	rt = new(recordingT)
+	assert.False(t, m.Equal(rt, 1, 1))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0][0], "unsupported type: int")
+}
+
+func TestMatcherHasAttributes(t *testing.T) {
+	m := NewMatcher()
+	m.HasAttributes(t, resourceMetricsA, attribute.Bool("A", true))
+
+	fakeT := &testing.T{}
+	assert.False(t, m.HasAttributes(fakeT, resourceMetricsA, attribute.Bool("A", false)))
+	assert.False(t, m.HasAttributes(fakeT, "not a metricdata type"))
+}
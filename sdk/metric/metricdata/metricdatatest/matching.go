@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+// pairElement pairs a[i] with an element of b it is equal to. The index of
+// the element of a paired with b[j] is stored in pairs[j], or -1 if b[j] is
+// not paired. It returns false if a[i] cannot be paired.
+//
+// An unpaired element of b is preferred. If there is none, elements already
+// paired are re-paired to other equal elements of b when that frees one for
+// a[i] (an augmenting path). Pairing all elements of a this way produces a
+// maximum matching: as many elements are paired as possible, regardless of
+// the order of a and b.
+//
+// When equal is not transitive, like when comparing with a tolerance, a
+// first-come pairing can leave elements unpaired that could all be paired.
+// The maximum matching ensures comparing a to b finds the same number of
+// pairs as comparing b to a, as long as equal is symmetric.
+//
+// The seen slice is scratch space that needs to have the same length as b.
+func pairElement[T any](a, b []T, i int, equal func(T, T) bool, pairs []int, seen []bool) bool {
+	for j := range b {
+		if pairs[j] < 0 && equal(a[i], b[j]) {
+			pairs[j] = i
+			return true
+		}
+	}
+
+	for j := range seen {
+		seen[j] = false
+	}
+	return augment(a, b, i, equal, pairs, seen)
+}
+
+// augment searches for an augmenting path starting at a[i]. See pairElement.
+func augment[T any](a, b []T, i int, equal func(T, T) bool, pairs []int, seen []bool) bool {
+	for j := range b {
+		if seen[j] || !equal(a[i], b[j]) {
+			continue
+		}
+		seen[j] = true
+		if pairs[j] < 0 || augment(a, b, pairs[j], equal, pairs, seen) {
+			pairs[j] = i
+			return true
+		}
+	}
+	return false
+}
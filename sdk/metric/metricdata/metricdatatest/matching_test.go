@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestDiffSlicesNonTransitive(t *testing.T) {
+	// With a tolerance of 1, 2 is equal to both 1 and 3. Pairing it with the
+	// first equal element, 1, leaves 1 without a pair.
+	equal := func(a, b int) bool { return math.Abs(float64(a-b)) <= 1 }
+	a, b := []int{2, 1}, []int{1, 3}
+
+	extraA, extraB := diffSlices(config{}, a, b, equal)
+	assert.Empty(t, extraA)
+	assert.Empty(t, extraB)
+	assert.True(t, matchSlices(config{}, a, b, equal))
+	assert.True(t, matchSlices(config{}, b, a, equal))
+
+	b = []int{1, 5}
+	extraA, extraB = diffSlices(config{}, a, b, equal)
+	assert.Len(t, extraA, 1)
+	assert.Equal(t, []int{5}, extraB)
+	extraB, extraA = diffSlices(config{}, b, a, equal)
+	assert.Len(t, extraA, 1)
+	assert.Equal(t, []int{5}, extraB)
+}
+
+func TestAssertEqualToleranceAmbiguousPairing(t *testing.T) {
+	// Both actual data points are within tolerance of the first expected
+	// data point. Pairing it with the first, 1.2, leaves 1.4 and 0.9.
+	expected := metricdata.Sum[float64]{DataPoints: []metricdata.DataPoint[float64]{
+		{Attributes: attrA, Value: 1.0},
+		{Attributes: attrA, Value: 1.4},
+	}}
+	actual := metricdata.Sum[float64]{DataPoints: []metricdata.DataPoint[float64]{
+		{Attributes: attrA, Value: 1.2},
+		{Attributes: attrA, Value: 0.9},
+	}}
+
+	opt := WithTolerance(0.3)
+	assert.True(t, AssertEqual(t, expected, actual, opt))
+	assert.True(t, AssertEqual(t, actual, expected, opt))
+	assert.True(t, EqualFast(expected, actual, opt))
+	assert.False(t, AssertEqual(new(recordingT), expected, actual, WithTolerance(0.15)))
+}
+
+func TestEqualValuesNaN(t *testing.T) {
+	assert.True(t, equalValues(math.NaN(), math.NaN(), config{}))
+	assert.False(t, equalValues(math.NaN(), 0, config{}))
+	assert.False(t, equalValues(0, math.NaN(), config{tolerance: 1}))
+}
+
+func fuzzSum(v0, v1, v2 float64, attrs uint8) metricdata.Sum[float64] {
+	set := func(n uint8) attribute.Set {
+		return attribute.NewSet(attribute.Int("n", int(n%2)))
+	}
+	return metricdata.Sum[float64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints: []metricdata.DataPoint[float64]{
+			{Attributes: set(attrs), Value: v0},
+			{Attributes: set(attrs >> 1), Value: v1},
+			{Attributes: set(attrs >> 2), Value: v2},
+		},
+	}
+}
+
+func FuzzEqualSymmetric(f *testing.F) {
+	f.Add(2.0, 1.0, 0.0, uint8(0), 1.0, 3.0, 0.0, uint8(0), 1.0)
+	f.Add(1.0, 2.0, 3.0, uint8(3), 3.0, 2.0, 1.0, uint8(6), 0.0)
+	f.Add(1.0, 1.5, 2.0, uint8(0), 2.0, 1.0, 1.5, uint8(0), 0.5)
+	f.Add(math.NaN(), 0.0, 0.0, uint8(1), 0.0, math.NaN(), 0.0, uint8(2), 0.0)
+
+	f.Fuzz(func(t *testing.T, a0, a1, a2 float64, aAttrs uint8, b0, b1, b2 float64, bAttrs uint8, tolerance float64) {
+		if math.IsNaN(tolerance) || tolerance < 0 || tolerance > 1e6 {
+			tolerance = 0
+		}
+		opts := []Option{WithTolerance(tolerance)}
+		a, b := fuzzSum(a0, a1, a2, aAttrs), fuzzSum(b0, b1, b2, bAttrs)
+
+		eqAB, rAB := Equal(a, b, opts...)
+		eqBA, rBA := Equal(b, a, opts...)
+		if eqAB != eqBA {
+			t.Fatalf("comparison not symmetric:\na to b: %v\nb to a: %v", rAB, rBA)
+		}
+		if fast := EqualFast(a, b, opts...); fast != eqAB {
+			t.Fatalf("EqualFast %t, Equal %t: %v", fast, eqAB, rAB)
+		}
+		for _, v := range []metricdata.Sum[float64]{a, b} {
+			if eq, r := Equal(v, v, opts...); !eq {
+				t.Fatalf("comparison not reflexive: %v", r)
+			}
+		}
+	})
+}
@@ -0,0 +1,338 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// diffFormat is the format the reasons of a failed assertion are reported in.
+type diffFormat int
+
+const (
+	// diffReasons reports the reasons of the comparison.
+	diffReasons diffFormat = iota
+	// diffPaths reports a line for each Difference.
+	diffPaths
+	// diffJSON reports the JSON encoding of the Differences.
+	diffJSON
+)
+
+// WithPathDiff reports the failures of assertions as a line for each
+// Difference returned by Diff instead of the reasons of the comparison. Each
+// line identifies the field path of a difference, like:
+//
+//	ScopeMetrics[0].Metrics[2].Data.DataPoints[1].Value: expected 5 got 6
+func WithPathDiff() Option {
+	return fnOption(func(cfg config) config {
+		cfg.diffFormat = diffPaths
+		return cfg
+	})
+}
+
+// WithJSONDiff reports the failures of assertions as the JSON encoding of
+// the Differences returned by Diff instead of the reasons of the comparison.
+// This can be used by tooling to process failures.
+func WithJSONDiff() Option {
+	return fnOption(func(cfg config) config {
+		cfg.diffFormat = diffJSON
+		return cfg
+	})
+}
+
+// Difference is a difference between an expected and an actual value.
+type Difference struct {
+	// Path is the field path of the difference relative to the compared
+	// values, like "ScopeMetrics[0].Metrics[2].Data.DataPoints[1].Value".
+	// Indexes are of elements in the expected value, unless the element is
+	// only in the actual value.
+	Path string
+	// Expected and Actual are the formatted expected and actual values.
+	Expected string
+	Actual   string
+	// Details describes a difference that is not between two values, like
+	// the differences of attribute sets or an element missing from the
+	// actual value. Expected and Actual are empty if Details is set.
+	Details string `json:",omitempty"`
+}
+
+// String returns the difference as a single line, or multiple lines if it
+// has Details.
+func (d Difference) String() string {
+	if d.Details != "" {
+		return fmt.Sprintf("%s:\n%s", d.Path, d.Details)
+	}
+	return fmt.Sprintf("%s: expected %s got %s", d.Path, d.Expected, d.Actual)
+}
+
+// Diff returns the differences between the two concrete data-types from the
+// metricdata package by field path. If they are equal, the returned
+// differences will be empty. The comparison is the same as AssertEqual.
+//
+// Elements are paired the same way as WithDiagnostics: ScopeMetrics by their
+// Scope, Metrics by their Name (or the key of WithMetricKey), and data points
+// by their Attributes. Elements without a pair are reported with Details.
+func Diff[T Datatypes](expected, actual T, opts ...Option) []Difference {
+	d := differ{cfg: newConfig(opts)}
+	d.value("", expected, actual)
+	return d.diffs
+}
+
+// pathReasons returns the differences between expected and actual in the
+// diff format of cfg. If no differences are found, reasons is returned.
+func (cfg config) pathReasons(expected, actual interface{}, reasons []string) []string {
+	d := differ{cfg: cfg}
+	d.value("", expected, actual)
+	if len(d.diffs) == 0 {
+		return reasons
+	}
+
+	if cfg.diffFormat == diffJSON {
+		b, err := json.MarshalIndent(d.diffs, "", "\t")
+		if err != nil {
+			return reasons
+		}
+		return []string{string(b)}
+	}
+	out := make([]string, len(d.diffs))
+	for i, diff := range d.diffs {
+		out[i] = diff.String()
+	}
+	return out
+}
+
+// differ collects the Differences of compared values.
+type differ struct {
+	cfg   config
+	diffs []Difference
+}
+
+func (d *differ) add(path string, expected, actual interface{}) {
+	d.diffs = append(d.diffs, Difference{
+		Path:     path,
+		Expected: fmt.Sprint(expected),
+		Actual:   fmt.Sprint(actual),
+	})
+}
+
+// value adds the differences between expected and actual at path.
+func (d *differ) value(path string, expected, actual interface{}) {
+	cfg := d.cfg
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		eAgg, eOk := expected.(metricdata.Aggregation)
+		aAgg, aOk := actual.(metricdata.Aggregation)
+		var ok bool
+		if eOk && aOk && cfg.numericTypeCoercion {
+			expected, actual, ok = coerceNumericTypes(eAgg, aAgg)
+		}
+		if !ok {
+			d.add(path, fmt.Sprintf("%T", expected), fmt.Sprintf("%T", actual))
+			return
+		}
+	}
+
+	switch e := expected.(type) {
+	case metricdata.ResourceMetrics:
+		a := actual.(metricdata.ResourceMetrics)
+		d.reasons(path, equalResources(e.Resource, a.Resource, cfg))
+		pairs, onlyE, onlyA := pairIndexes(e.ScopeMetrics, a.ScopeMetrics, scopeMetricsKey(cfg))
+		d.paired(joinPath(path, "ScopeMetrics"), pairs, onlyE, onlyA,
+			func(p string, i, j int) { d.value(p, e.ScopeMetrics[i], a.ScopeMetrics[j]) },
+			func(i int) interface{} { return e.ScopeMetrics[i] },
+			func(j int) interface{} { return a.ScopeMetrics[j] },
+		)
+	case metricdata.ScopeMetrics:
+		a := actual.(metricdata.ScopeMetrics)
+		d.reasons(path, equalScopes(e.Scope, a.Scope, cfg))
+		// Indexes are of the unfiltered Metrics.
+		eIdx, aIdx := metricIndexes(e.Metrics, cfg), metricIndexes(a.Metrics, cfg)
+		eMetrics, aMetrics := make([]metricdata.Metrics, len(eIdx)), make([]metricdata.Metrics, len(aIdx))
+		for n, i := range eIdx {
+			eMetrics[n] = e.Metrics[i]
+		}
+		for n, j := range aIdx {
+			aMetrics[n] = a.Metrics[j]
+		}
+		pairs, onlyE, onlyA := pairIndexes(eMetrics, aMetrics, metricsKey(cfg))
+		for n := range pairs {
+			pairs[n] = [2]int{eIdx[pairs[n][0]], aIdx[pairs[n][1]]}
+		}
+		for n := range onlyE {
+			onlyE[n] = eIdx[onlyE[n]]
+		}
+		for n := range onlyA {
+			onlyA[n] = aIdx[onlyA[n]]
+		}
+		d.paired(joinPath(path, "Metrics"), pairs, onlyE, onlyA,
+			func(p string, i, j int) { d.value(p, e.Metrics[i], a.Metrics[j]) },
+			func(i int) interface{} { return e.Metrics[i] },
+			func(j int) interface{} { return a.Metrics[j] },
+		)
+	case metricdata.Metrics:
+		a := actual.(metricdata.Metrics)
+		if e.Name != a.Name {
+			d.add(joinPath(path, "Name"), e.Name, a.Name)
+		}
+		if e.Description != a.Description {
+			d.add(joinPath(path, "Description"), e.Description, a.Description)
+		}
+		if e.Unit != a.Unit {
+			d.add(joinPath(path, "Unit"), e.Unit, a.Unit)
+		}
+		if e.Data == nil || a.Data == nil {
+			if e.Data != a.Data {
+				d.add(joinPath(path, "Data"), e.Data, a.Data)
+			}
+			return
+		}
+		d.value(joinPath(path, "Data"), e.Data, a.Data)
+	case metricdata.Gauge[int64]:
+		pathDiffDataPoints(d, path, e.DataPoints, actual.(metricdata.Gauge[int64]).DataPoints, dataPointAttrs[int64], equalDataPoints[int64])
+	case metricdata.Gauge[float64]:
+		pathDiffDataPoints(d, path, e.DataPoints, actual.(metricdata.Gauge[float64]).DataPoints, dataPointAttrs[float64], equalDataPoints[float64])
+	case metricdata.Sum[int64]:
+		e, a := normalizeSum(e, cfg), normalizeSum(actual.(metricdata.Sum[int64]), cfg)
+		d.aggregationFields(path, e.Temporality, a.Temporality, e.IsMonotonic, a.IsMonotonic)
+		pathDiffDataPoints(d, path, e.DataPoints, a.DataPoints, dataPointAttrs[int64], equalDataPoints[int64])
+	case metricdata.Sum[float64]:
+		e, a := normalizeSum(e, cfg), normalizeSum(actual.(metricdata.Sum[float64]), cfg)
+		d.aggregationFields(path, e.Temporality, a.Temporality, e.IsMonotonic, a.IsMonotonic)
+		pathDiffDataPoints(d, path, e.DataPoints, a.DataPoints, dataPointAttrs[float64], equalDataPoints[float64])
+	case metricdata.Histogram[int64]:
+		e, a := normalizeHistogram(e, cfg), normalizeHistogram(actual.(metricdata.Histogram[int64]), cfg)
+		d.aggregationFields(path, e.Temporality, a.Temporality, false, false)
+		pathDiffDataPoints(d, path, e.DataPoints, a.DataPoints, histogramDataPointAttrs[int64], equalHistogramDataPoints[int64])
+	case metricdata.Histogram[float64]:
+		e, a := normalizeHistogram(e, cfg), normalizeHistogram(actual.(metricdata.Histogram[float64]), cfg)
+		d.aggregationFields(path, e.Temporality, a.Temporality, false, false)
+		pathDiffDataPoints(d, path, e.DataPoints, a.DataPoints, histogramDataPointAttrs[float64], equalHistogramDataPoints[float64])
+	case metricdata.ExponentialHistogram[int64]:
+		a := actual.(metricdata.ExponentialHistogram[int64])
+		d.aggregationFields(path, e.Temporality, a.Temporality, false, false)
+		pathDiffDataPoints(d, path, e.DataPoints, a.DataPoints, exponentialHistogramDataPointAttrs[int64], equalExponentialHistogramDataPoints[int64])
+	case metricdata.ExponentialHistogram[float64]:
+		a := actual.(metricdata.ExponentialHistogram[float64])
+		d.aggregationFields(path, e.Temporality, a.Temporality, false, false)
+		pathDiffDataPoints(d, path, e.DataPoints, a.DataPoints, exponentialHistogramDataPointAttrs[float64], equalExponentialHistogramDataPoints[float64])
+	default:
+		// Data points, Exemplars, Extrema, and ExponentialBuckets do not
+		// contain elements that need to be paired.
+		if r, ok := equalIfaces(expected, actual, cfg); ok {
+			d.reasons(path, r)
+		}
+	}
+}
+
+func (d *differ) aggregationFields(path string, eTemp, aTemp metricdata.Temporality, eMono, aMono bool) {
+	if !d.cfg.ignoreTemporality && eTemp != aTemp {
+		d.add(joinPath(path, "Temporality"), eTemp, aTemp)
+	}
+	if !d.cfg.ignoreMonotonicity && eMono != aMono {
+		d.add(joinPath(path, "IsMonotonic"), eMono, aMono)
+	}
+}
+
+// paired adds the differences of the elements of a slice at path. The
+// elements at the indexes of pairs are compared with value. The elements at
+// the indexes of onlyE and onlyA are reported as missing and unexpected.
+func (d *differ) paired(path string, pairs [][2]int, onlyE, onlyA []int, value func(string, int, int), expected, actual func(int) interface{}) {
+	for _, p := range pairs {
+		value(fmt.Sprintf("%s[%d]", path, p[0]), p[0], p[1])
+	}
+	for _, i := range onlyE {
+		d.diffs = append(d.diffs, Difference{
+			Path:    fmt.Sprintf("%s[%d]", path, i),
+			Details: "missing expected value:\n" + d.cfg.format(expected(i)),
+		})
+	}
+	if d.cfg.subset {
+		return
+	}
+	for _, j := range onlyA {
+		d.diffs = append(d.diffs, Difference{
+			Path:    fmt.Sprintf("%s[%d]", path, j),
+			Details: "unexpected additional value:\n" + d.cfg.format(actual(j)),
+		})
+	}
+}
+
+// pathDiffDataPoints adds the differences between the data points of expected
+// and actual to d. Data points are paired by their attributes, or by their
+// position if cfg compares data points in order.
+func pathDiffDataPoints[T any](d *differ, path string, expected, actual []T, attrs func(T) attribute.Set, equal func(T, T, config) []string) {
+	var pairs [][2]int
+	var onlyE, onlyA []int
+	if d.cfg.orderedDataPoints {
+		pairs, onlyE, onlyA = pairPositions(len(expected), len(actual))
+	} else {
+		pairs, onlyE, onlyA = pairIndexes(expected, actual, dataPointKey(d.cfg, attrs))
+	}
+	d.paired(joinPath(path, "DataPoints"), pairs, onlyE, onlyA,
+		func(p string, i, j int) { d.reasons(p, equal(expected[i], actual[j], d.cfg)) },
+		func(i int) interface{} { return expected[i] },
+		func(j int) interface{} { return actual[j] },
+	)
+}
+
+// reasons adds the reasons of a comparison of the values at path as
+// differences. Reasons in the format of notEqualStr are split into their
+// field, expected, and actual value. All other reasons are added as Details
+// of their field.
+func (d *differ) reasons(path string, reasons []string) {
+	prefix := path
+	for _, r := range reasons {
+		field, rest, ok := strings.Cut(r, " not equal:")
+		if !ok {
+			d.diffs = append(d.diffs, Difference{Path: path, Details: r})
+			continue
+		}
+		if rest == "" {
+			// A header of the following reasons, like the PositiveBucket
+			// of ExponentialHistogramDataPoints.
+			prefix = joinPath(path, field)
+			continue
+		}
+		if field != "Offset" && !strings.HasPrefix(field, "Counts") {
+			// Only ExponentialBucket reasons follow a header.
+			prefix = path
+		}
+
+		// Remove annotations of the field, like the bucket index of Counts.
+		field, _, _ = strings.Cut(field, " (")
+		p := joinPath(prefix, strings.ReplaceAll(field, " ", "."))
+		if v, ok := strings.CutPrefix(rest, "\nexpected: "); ok {
+			if e, a, ok := strings.Cut(v, "\nactual: "); ok {
+				// Remove the difference of numeric values.
+				a, _, _ = strings.Cut(a, " (diff ")
+				d.diffs = append(d.diffs, Difference{Path: p, Expected: e, Actual: a})
+				continue
+			}
+		}
+		d.diffs = append(d.diffs, Difference{Path: p, Details: strings.TrimPrefix(rest, "\n")})
+	}
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
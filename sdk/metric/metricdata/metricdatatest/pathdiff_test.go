@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func pathDiffResourceMetrics(value int64) metricdata.ResourceMetrics {
+	return metricdata.ResourceMetrics{
+		Resource: resourceMetricsA.Resource,
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "scope"},
+			Metrics: []metricdata.Metrics{
+				{Name: "a", Data: metricdata.Gauge[int64]{}},
+				{Name: "b", Data: metricdata.Gauge[int64]{}},
+				{Name: "c", Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attrA, Value: 1},
+						{Attributes: attrB, Value: value},
+					},
+				}},
+			},
+		}},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	expected, actual := pathDiffResourceMetrics(5), pathDiffResourceMetrics(6)
+	assert.Empty(t, Diff(expected, expected))
+
+	want := Difference{
+		Path:     "ScopeMetrics[0].Metrics[2].Data.DataPoints[1].Value",
+		Expected: "5",
+		Actual:   "6",
+	}
+	assert.Equal(t, []Difference{want}, Diff(expected, actual))
+	assert.Equal(t, "ScopeMetrics[0].Metrics[2].Data.DataPoints[1].Value: expected 5 got 6", want.String())
+	assert.Empty(t, Diff(expected, actual, WithTolerance(1)))
+
+	// Indexes of filtered Metrics are of the unfiltered Metrics.
+	assert.Equal(t, []Difference{want}, Diff(expected, actual, OnlyMetrics("c")))
+
+	actual.ScopeMetrics[0].Metrics = actual.ScopeMetrics[0].Metrics[1:]
+	got := Diff(expected, actual)
+	require.Len(t, got, 2)
+	assert.Equal(t, want, got[0])
+	assert.Equal(t, "ScopeMetrics[0].Metrics[0]", got[1].Path)
+	assert.True(t, strings.HasPrefix(got[1].Details, "missing expected value:\n"), got[1].Details)
+	assert.Len(t, Diff(actual, expected), 2)
+}
+
+func TestDiffFields(t *testing.T) {
+	got := Diff(
+		metricdata.Metrics{Name: "a", Unit: "ms", Data: metricdata.Sum[int64]{}},
+		metricdata.Metrics{Name: "a", Unit: "s", Data: metricdata.Sum[float64]{}},
+	)
+	assert.Equal(t, []Difference{
+		{Path: "Unit", Expected: "ms", Actual: "s"},
+		{Path: "Data", Expected: "metricdata.Sum[int64]", Actual: "metricdata.Sum[float64]"},
+	}, got)
+
+	a := exponentialHistogramDataPointInt64A
+	b := a
+	b.PositiveBucket = metricdata.ExponentialBucket{Offset: 3, Counts: []uint64{1, 2}}
+	b.Exemplars = nil
+	got = Diff(a, b)
+	require.Len(t, got, 2)
+	assert.Equal(t, Difference{Path: "PositiveBucket.Counts[1]", Expected: "1", Actual: "2"}, got[0])
+	assert.Equal(t, "Exemplars", got[1].Path, "bucket header not applied")
+	assert.NotEmpty(t, got[1].Details)
+}
+
+func TestWithPathDiff(t *testing.T) {
+	expected, actual := pathDiffResourceMetrics(5), pathDiffResourceMetrics(6)
+
+	rT := new(recordingT)
+	assert.False(t, AssertEqual(rT, expected, actual, WithPathDiff()))
+	require.Len(t, rT.errors, 1)
+	assert.Equal(t, fmt.Sprint([]string{
+		"ScopeMetrics[0].Metrics[2].Data.DataPoints[1].Value: expected 5 got 6",
+	}), fmt.Sprint(rT.errors[0]...))
+
+	// Explain formats the single JSON reason as a slice.
+	msg := Explain(expected, actual, WithJSONDiff())
+	msg = strings.TrimSuffix(strings.TrimPrefix(msg, "["), "]")
+	var got []Difference
+	require.NoError(t, json.Unmarshal([]byte(msg), &got))
+	assert.Equal(t, Diff(expected, actual), got)
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// MetricPredicate returns the reasons a Metrics does not satisfy it. If it
+// is satisfied, the returned reasons need to be empty.
+type MetricPredicate func(metricdata.Metrics) []string
+
+// AssertHasMetricMatching asserts that rm contains a Metrics named name that
+// satisfies all preds. If multiple Metrics of rm are named name, at least
+// one of them needs to satisfy all preds.
+//
+// This can be used to assert parts of a collection without defining the
+// complete expected ResourceMetrics.
+func AssertHasMetricMatching(t TestingT, rm metricdata.ResourceMetrics, name string, preds ...MetricPredicate) bool {
+	t.Helper()
+
+	if r := HasMetricMatching(rm, name, preds...); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// HasMetricMatching returns the reasons rm does not contain a Metrics named
+// name that satisfies all preds. If it does, the returned reasons will be
+// empty. The check is the same as AssertHasMetricMatching.
+//
+// This can be used to build assertions or validators for other testing
+// frameworks.
+func HasMetricMatching(rm metricdata.ResourceMetrics, name string, preds ...MetricPredicate) []string {
+	var (
+		reasons []string
+		names   []string
+		found   bool
+	)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != name {
+				names = append(names, m.Name)
+				continue
+			}
+			found = true
+
+			var r []string
+			for _, pred := range preds {
+				r = append(r, pred(m)...)
+			}
+			if len(r) == 0 {
+				return nil
+			}
+			for _, reason := range r {
+				reasons = append(reasons, fmt.Sprintf("Metric %q of Scope %q: %s", name, sm.Scope.Name, reason))
+			}
+		}
+	}
+	if !found {
+		return []string{fmt.Sprintf("Metric %q not found, have %q", name, names)}
+	}
+	return reasons
+}
+
+// HasDataPointWhere returns a MetricPredicate that is satisfied if the
+// Metrics contains a Gauge or Sum with a DataPoint for which match returns
+// true.
+func HasDataPointWhere[N int64 | float64](match func(metricdata.DataPoint[N]) bool) MetricPredicate {
+	return func(m metricdata.Metrics) []string {
+		var dPts []metricdata.DataPoint[N]
+		switch a := m.Data.(type) {
+		case metricdata.Gauge[N]:
+			dPts = a.DataPoints
+		case metricdata.Sum[N]:
+			dPts = a.DataPoints
+		default:
+			var n N
+			return []string{fmt.Sprintf("unsupported aggregation %T: expected Gauge[%[2]T] or Sum[%[2]T]", m.Data, n)}
+		}
+		return dataPointWhere(dPts, match)
+	}
+}
+
+// HasHistogramDataPointWhere returns a MetricPredicate that is satisfied if
+// the Metrics contains a Histogram with a HistogramDataPoint for which match
+// returns true.
+func HasHistogramDataPointWhere[N int64 | float64](match func(metricdata.HistogramDataPoint[N]) bool) MetricPredicate {
+	return func(m metricdata.Metrics) []string {
+		a, ok := m.Data.(metricdata.Histogram[N])
+		if !ok {
+			var n N
+			return []string{fmt.Sprintf("unsupported aggregation %T: expected Histogram[%T]", m.Data, n)}
+		}
+		return dataPointWhere(a.DataPoints, match)
+	}
+}
+
+// HasExponentialHistogramDataPointWhere returns a MetricPredicate that is
+// satisfied if the Metrics contains an ExponentialHistogram with an
+// ExponentialHistogramDataPoint for which match returns true.
+func HasExponentialHistogramDataPointWhere[N int64 | float64](match func(metricdata.ExponentialHistogramDataPoint[N]) bool) MetricPredicate {
+	return func(m metricdata.Metrics) []string {
+		a, ok := m.Data.(metricdata.ExponentialHistogram[N])
+		if !ok {
+			var n N
+			return []string{fmt.Sprintf("unsupported aggregation %T: expected ExponentialHistogram[%T]", m.Data, n)}
+		}
+		return dataPointWhere(a.DataPoints, match)
+	}
+}
+
+// dataPointWhere returns reasons none of dPts match. If one does, the
+// returned reasons will be empty.
+func dataPointWhere[T any](dPts []T, match func(T) bool) []string {
+	for _, dp := range dPts {
+		if match(dp) {
+			return nil
+		}
+	}
+	reason := fmt.Sprintf("none of %d data points matched", len(dPts))
+	for _, dp := range dPts {
+		reason += "\n" + Dump(dp)
+	}
+	return []string{reason}
+}
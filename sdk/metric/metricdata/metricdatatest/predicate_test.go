@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestAssertHasMetricMatching(t *testing.T) {
+	negative := HasDataPointWhere(func(dp metricdata.DataPoint[int64]) bool {
+		return dp.Value < 0
+	})
+	positive := HasDataPointWhere(func(dp metricdata.DataPoint[int64]) bool {
+		return dp.Value > 0
+	})
+
+	AssertHasMetricMatching(t, resourceMetricsA, "A")
+	AssertHasMetricMatching(t, resourceMetricsA, "A", negative)
+	assert.False(t, AssertHasMetricMatching(&testing.T{}, resourceMetricsA, "A", positive))
+
+	r := HasMetricMatching(resourceMetricsA, "A", positive)
+	require.Len(t, r, 1)
+	assert.Contains(t, r[0], `Metric "A" of Scope "A": none of 1 data points matched`)
+
+	r = HasMetricMatching(resourceMetricsA, "B", negative)
+	assert.Equal(t, []string{`Metric "B" not found, have ["A"]`}, r)
+
+	r = HasMetricMatching(resourceMetricsB, "B", negative)
+	require.Len(t, r, 1)
+	assert.Contains(t, r[0], "unsupported aggregation metricdata.Gauge[float64]")
+
+	sm := metricdata.ScopeMetrics{
+		Metrics: []metricdata.Metrics{{
+			Name: "A",
+			Data: metricdata.Sum[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{{Value: 1}},
+			},
+		}},
+	}
+	rm := metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{scopeMetricsA, sm},
+	}
+	AssertHasMetricMatching(t, rm, "A", positive)
+}
+
+func TestHasHistogramDataPointWhere(t *testing.T) {
+	m := metricdata.Metrics{Name: "h", Data: histogramInt64A}
+	assert.Empty(t, HasHistogramDataPointWhere(func(dp metricdata.HistogramDataPoint[int64]) bool {
+		return dp.Count == histogramDataPointInt64A.Count
+	})(m))
+	assert.NotEmpty(t, HasHistogramDataPointWhere(func(dp metricdata.HistogramDataPoint[int64]) bool {
+		return false
+	})(m))
+	assert.NotEmpty(t, HasHistogramDataPointWhere(func(dp metricdata.HistogramDataPoint[float64]) bool {
+		return true
+	})(m))
+
+	m = metricdata.Metrics{Name: "e", Data: exponentialHistogramInt64A}
+	assert.Empty(t, HasExponentialHistogramDataPointWhere(func(dp metricdata.ExponentialHistogramDataPoint[int64]) bool {
+		return dp.Count == exponentialHistogramDataPointInt64A.Count
+	})(m))
+}
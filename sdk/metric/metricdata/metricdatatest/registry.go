@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"reflect"
+	"sync"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// AggregationComparer returns reasons the Aggregations expected and actual
+// are not equal. If they are equal, the returned reasons need to be empty.
+type AggregationComparer func(expected, actual metricdata.Aggregation) []string
+
+var (
+	comparersMu sync.RWMutex
+	comparers   map[reflect.Type]AggregationComparer
+)
+
+// RegisterAggregationComparer registers cmp to compare Aggregations of type
+// typ. This allows Aggregations not defined in the metricdata package to be
+// compared by AssertEqual, AssertAggregationsEqual, and all other
+// comparisons of Aggregations.
+//
+// The comparer is only used when both Aggregations are of type typ. The
+// Aggregations defined in the metricdata package are always compared
+// directly and cannot be overridden. Registering a comparer for a type that
+// already has one replaces it, and a nil cmp removes it.
+//
+// It is safe to call RegisterAggregationComparer concurrently with
+// comparisons.
+func RegisterAggregationComparer(typ reflect.Type, cmp AggregationComparer) {
+	comparersMu.Lock()
+	defer comparersMu.Unlock()
+
+	if cmp == nil {
+		delete(comparers, typ)
+		return
+	}
+	if comparers == nil {
+		comparers = make(map[reflect.Type]AggregationComparer)
+	}
+	comparers[typ] = cmp
+}
+
+func lookupAggregationComparer(typ reflect.Type) (AggregationComparer, bool) {
+	comparersMu.RLock()
+	defer comparersMu.RUnlock()
+
+	cmp, ok := comparers[typ]
+	return cmp, ok
+}
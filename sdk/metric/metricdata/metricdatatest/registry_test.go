@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// customAggregation is an Aggregation not defined in the metricdata package.
+type customAggregation struct {
+	metricdata.Gauge[int64]
+
+	Value int
+}
+
+func TestRegisterAggregationComparer(t *testing.T) {
+	typ := reflect.TypeOf(customAggregation{})
+	a, b := customAggregation{Value: 1}, customAggregation{Value: 2}
+
+	assert.Equal(t, []string{"Aggregation of unknown types metricdatatest.customAggregation"}, equalAggregations(a, a, config{}))
+
+	RegisterAggregationComparer(typ, func(expected, actual metricdata.Aggregation) []string {
+		e, a := expected.(customAggregation), actual.(customAggregation)
+		if e.Value != a.Value {
+			return []string{fmt.Sprintf("Value not equal: %d, %d", e.Value, a.Value)}
+		}
+		return nil
+	})
+	t.Cleanup(func() { RegisterAggregationComparer(typ, nil) })
+
+	AssertAggregationsEqual(t, a, a)
+	assert.Equal(t, []string{"Value not equal: 1, 2"}, equalAggregations(a, b, config{}))
+	assert.Len(t, equalAggregations(a, metricdata.Gauge[int64]{}, config{}), 1, "types should differ")
+
+	RegisterAggregationComparer(typ, nil)
+	_, ok := lookupAggregationComparer(typ)
+	assert.False(t, ok, "comparer should be removed")
+}
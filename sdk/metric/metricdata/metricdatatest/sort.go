@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// SortResourceMetrics sorts the contents of rm in place into a canonical
+// order. ScopeMetrics are sorted by scope name and version, Metrics by name,
+// and the DataPoints of each aggregation by their encoded attributes, start
+// time, and time. ExponentialHistogram data points that are otherwise equal
+// are ordered by their scale and bucket offsets.
+//
+// Sorted values can be compared with reflect.DeepEqual or a serialized form
+// without relying on the order independent comparison of AssertEqual. This
+// is useful for snapshot testing.
+func SortResourceMetrics(rm *metricdata.ResourceMetrics) {
+	sort.SliceStable(rm.ScopeMetrics, func(i, j int) bool {
+		a, b := rm.ScopeMetrics[i].Scope, rm.ScopeMetrics[j].Scope
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Version < b.Version
+	})
+	for i := range rm.ScopeMetrics {
+		sortScopeMetrics(&rm.ScopeMetrics[i])
+	}
+}
+
+func sortScopeMetrics(sm *metricdata.ScopeMetrics) {
+	sort.SliceStable(sm.Metrics, func(i, j int) bool {
+		return sm.Metrics[i].Name < sm.Metrics[j].Name
+	})
+	for _, m := range sm.Metrics {
+		sortAggregation(m.Data)
+	}
+}
+
+// sortAggregation sorts the DataPoints of agg in place. The DataPoints slice
+// of the aggregation value shares its backing array with the caller, so the
+// caller observes the new order.
+func sortAggregation(agg metricdata.Aggregation) {
+	switch a := agg.(type) {
+	case metricdata.Gauge[int64]:
+		sortDataPoints(a.DataPoints)
+	case metricdata.Gauge[float64]:
+		sortDataPoints(a.DataPoints)
+	case metricdata.Sum[int64]:
+		sortDataPoints(a.DataPoints)
+	case metricdata.Sum[float64]:
+		sortDataPoints(a.DataPoints)
+	case metricdata.Histogram[int64]:
+		sortHistogramDataPoints(a.DataPoints)
+	case metricdata.Histogram[float64]:
+		sortHistogramDataPoints(a.DataPoints)
+	case metricdata.Summary[int64]:
+		sortSummaryDataPoints(a.DataPoints)
+	case metricdata.Summary[float64]:
+		sortSummaryDataPoints(a.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		sortExponentialHistogramDataPoints(a.DataPoints)
+	case metricdata.ExponentialHistogram[float64]:
+		sortExponentialHistogramDataPoints(a.DataPoints)
+	}
+}
+
+func sortDataPoints[N int64 | float64](dPts []metricdata.DataPoint[N]) {
+	sort.SliceStable(dPts, func(i, j int) bool {
+		a, b := dPts[i], dPts[j]
+		return compareSeries(a.Attributes, b.Attributes, a.StartTime, b.StartTime, a.Time, b.Time) < 0
+	})
+}
+
+func sortHistogramDataPoints[N int64 | float64](dPts []metricdata.HistogramDataPoint[N]) {
+	sort.SliceStable(dPts, func(i, j int) bool {
+		a, b := dPts[i], dPts[j]
+		return compareSeries(a.Attributes, b.Attributes, a.StartTime, b.StartTime, a.Time, b.Time) < 0
+	})
+}
+
+func sortSummaryDataPoints[N int64 | float64](dPts []metricdata.SummaryDataPoint[N]) {
+	sort.SliceStable(dPts, func(i, j int) bool {
+		a, b := dPts[i], dPts[j]
+		return compareSeries(a.Attributes, b.Attributes, a.StartTime, b.StartTime, a.Time, b.Time) < 0
+	})
+}
+
+func sortExponentialHistogramDataPoints[N int64 | float64](dPts []metricdata.ExponentialHistogramDataPoint[N]) {
+	sort.SliceStable(dPts, func(i, j int) bool {
+		a, b := dPts[i], dPts[j]
+		if c := compareSeries(a.Attributes, b.Attributes, a.StartTime, b.StartTime, a.Time, b.Time); c != 0 {
+			return c < 0
+		}
+		if a.Scale != b.Scale {
+			return a.Scale < b.Scale
+		}
+		if a.PositiveBucket.Offset != b.PositiveBucket.Offset {
+			return a.PositiveBucket.Offset < b.PositiveBucket.Offset
+		}
+		return a.NegativeBucket.Offset < b.NegativeBucket.Offset
+	})
+}
+
+// compareSeries returns -1, 0, or 1 if the data point identified by aAttr,
+// aStart, and aTime is ordered before, the same as, or after the data point
+// identified by bAttr, bStart, and bTime.
+func compareSeries(aAttr, bAttr attribute.Set, aStart, bStart, aTime, bTime time.Time) int {
+	enc := attribute.DefaultEncoder()
+	if a, b := aAttr.Encoded(enc), bAttr.Encoded(enc); a != b {
+		if a < b {
+			return -1
+		}
+		return 1
+	}
+	if !aStart.Equal(bStart) {
+		if aStart.Before(bStart) {
+			return -1
+		}
+		return 1
+	}
+	if !aTime.Equal(bTime) {
+		if aTime.Before(bTime) {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestSortResourceMetrics(t *testing.T) {
+	unsorted := func() metricdata.ResourceMetrics {
+		return metricdata.ResourceMetrics{
+			ScopeMetrics: []metricdata.ScopeMetrics{
+				{Scope: instrumentation.Scope{Name: "b"}},
+				{
+					Scope: instrumentation.Scope{Name: "a"},
+					Metrics: []metricdata.Metrics{
+						{
+							Name: "sum",
+							Data: metricdata.Sum[int64]{
+								DataPoints: []metricdata.DataPoint[int64]{dataPointInt64B, dataPointInt64C, dataPointInt64A},
+							},
+						},
+						{
+							Name: "histogram",
+							Data: metricdata.Histogram[int64]{
+								DataPoints: []metricdata.HistogramDataPoint[int64]{
+									histogramDataPointInt64B,
+									histogramDataPointInt64A,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	rm := unsorted()
+	SortResourceMetrics(&rm)
+
+	sorted := unsorted()
+	sorted.ScopeMetrics[0], sorted.ScopeMetrics[1] = sorted.ScopeMetrics[1], sorted.ScopeMetrics[0]
+	sorted.ScopeMetrics[0].Metrics = []metricdata.Metrics{
+		{
+			Name: "histogram",
+			Data: metricdata.Histogram[int64]{
+				DataPoints: []metricdata.HistogramDataPoint[int64]{
+					histogramDataPointInt64A,
+					histogramDataPointInt64B,
+				},
+			},
+		},
+		{
+			Name: "sum",
+			Data: metricdata.Sum[int64]{
+				// dataPointInt64A and dataPointInt64C have the same
+				// attributes, but dataPointInt64A starts earlier.
+				DataPoints: []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64C, dataPointInt64B},
+			},
+		},
+	}
+	assert.Equal(t, sorted, rm)
+
+	// Sorting is idempotent.
+	SortResourceMetrics(&rm)
+	assert.Equal(t, sorted, rm)
+}
@@ -0,0 +1,223 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"sort"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// NormalizeTemporality converts Sums and Histograms to temporality before
+// they are compared. This can be useful to compare the output of
+// aggregations that only differ in their temporality configuration.
+//
+// Delta data points are converted to cumulative by summing the data points
+// of each series in order of their Time, as AccumulateDelta does. Cumulative
+// data points are converted to delta by the difference of consecutive data
+// points of each series.
+//
+// The Min and Max of a Histogram data point cannot be determined from
+// cumulative values. When converting cumulative Histograms to delta, the
+// Min and Max of all data points but the first of each series are removed.
+// Prefer normalizing Histograms to cumulative.
+func NormalizeTemporality(temporality metricdata.Temporality) Option {
+	return fnOption(func(cfg config) config {
+		cfg.normalizeTemporality = temporality
+		return cfg
+	})
+}
+
+// AccumulateDelta returns the cumulative data points of the delta data points
+// in points. The data points of each series, identified by their Attributes,
+// are summed in order of their Time. Each returned data point has the
+// StartTime of the first data point in its series and the sum of the values
+// of all data points of the series up to and including it.
+//
+// The returned data points are in the same order as points. The points are
+// not modified.
+func AccumulateDelta[N int64 | float64](points []metricdata.DataPoint[N]) []metricdata.DataPoint[N] {
+	out := make([]metricdata.DataPoint[N], len(points))
+	copy(out, points)
+	for _, series := range seriesByTime(out, dataPointAttrs[N], dataPointTime[N]) {
+		first := out[series[0]]
+		var total N
+		for _, i := range series {
+			total += out[i].Value
+			out[i].StartTime = first.StartTime
+			out[i].Value = total
+		}
+	}
+	return out
+}
+
+// differenceCumulative returns the delta data points of the cumulative data
+// points in points. It is the inverse of AccumulateDelta.
+func differenceCumulative[N int64 | float64](points []metricdata.DataPoint[N]) []metricdata.DataPoint[N] {
+	out := make([]metricdata.DataPoint[N], len(points))
+	copy(out, points)
+	for _, series := range seriesByTime(out, dataPointAttrs[N], dataPointTime[N]) {
+		for k := len(series) - 1; k > 0; k-- {
+			curr, prev := &out[series[k]], points[series[k-1]]
+			curr.StartTime = prev.Time
+			curr.Value -= prev.Value
+		}
+	}
+	return out
+}
+
+// accumulateHistogramDelta returns the cumulative data points of the delta
+// data points in points. Bucket counts are only summed for data points with
+// the same Bounds as the first data point in their series.
+func accumulateHistogramDelta[N int64 | float64](points []metricdata.HistogramDataPoint[N]) []metricdata.HistogramDataPoint[N] {
+	out := make([]metricdata.HistogramDataPoint[N], len(points))
+	copy(out, points)
+	for _, series := range seriesByTime(out, histogramDataPointAttrs[N], histogramDataPointTime[N]) {
+		first := out[series[0]]
+		total := first
+		total.BucketCounts = append([]uint64(nil), first.BucketCounts...)
+		for k, i := range series {
+			if k > 0 {
+				dp := out[i]
+				total.Count += dp.Count
+				total.Sum += dp.Sum
+				total.Min = minExtrema(total.Min, dp.Min)
+				total.Max = maxExtrema(total.Max, dp.Max)
+				if equalSlices(total.Bounds, dp.Bounds) {
+					for b := range total.BucketCounts {
+						total.BucketCounts[b] += dp.BucketCounts[b]
+					}
+				}
+			}
+			out[i].StartTime = first.StartTime
+			out[i].Count = total.Count
+			out[i].Sum = total.Sum
+			out[i].Min = total.Min
+			out[i].Max = total.Max
+			out[i].BucketCounts = append([]uint64(nil), total.BucketCounts...)
+		}
+	}
+	return out
+}
+
+// differenceHistogramCumulative returns the delta data points of the
+// cumulative data points in points. The Min and Max of all but the first
+// data point of each series are removed.
+func differenceHistogramCumulative[N int64 | float64](points []metricdata.HistogramDataPoint[N]) []metricdata.HistogramDataPoint[N] {
+	out := make([]metricdata.HistogramDataPoint[N], len(points))
+	copy(out, points)
+	for _, series := range seriesByTime(out, histogramDataPointAttrs[N], histogramDataPointTime[N]) {
+		for k := len(series) - 1; k > 0; k-- {
+			curr, prev := &out[series[k]], points[series[k-1]]
+			curr.StartTime = prev.Time
+			curr.Count -= prev.Count
+			curr.Sum -= prev.Sum
+			curr.Min = metricdata.Extrema[N]{}
+			curr.Max = metricdata.Extrema[N]{}
+			if equalSlices(curr.Bounds, prev.Bounds) {
+				counts := make([]uint64, len(curr.BucketCounts))
+				for b := range counts {
+					counts[b] = curr.BucketCounts[b] - prev.BucketCounts[b]
+				}
+				curr.BucketCounts = counts
+			}
+		}
+	}
+	return out
+}
+
+func minExtrema[N int64 | float64](a, b metricdata.Extrema[N]) metricdata.Extrema[N] {
+	aV, aOk := a.Value()
+	bV, bOk := b.Value()
+	if !aOk || (bOk && bV < aV) {
+		return b
+	}
+	return a
+}
+
+func maxExtrema[N int64 | float64](a, b metricdata.Extrema[N]) metricdata.Extrema[N] {
+	aV, aOk := a.Value()
+	bV, bOk := b.Value()
+	if !aOk || (bOk && bV > aV) {
+		return b
+	}
+	return a
+}
+
+// seriesByTime returns the indexes of the elements of dps grouped by their
+// attributes. The indexes of each group are sorted by the time of their
+// element. Groups are in the order their first element appears in dps.
+func seriesByTime[T any](dps []T, attrs func(T) attribute.Set, ts func(T) int64) [][]int {
+	var series [][]int
+	index := make(map[attribute.Distinct]int)
+	for i, dp := range dps {
+		set := attrs(dp)
+		key := set.Equivalent()
+		n, ok := index[key]
+		if !ok {
+			n = len(series)
+			index[key] = n
+			series = append(series, nil)
+		}
+		series[n] = append(series[n], i)
+	}
+	for _, s := range series {
+		sort.SliceStable(s, func(i, j int) bool {
+			return ts(dps[s[i]]) < ts(dps[s[j]])
+		})
+	}
+	return series
+}
+
+func dataPointTime[N int64 | float64](dp metricdata.DataPoint[N]) int64 {
+	return dp.Time.UnixNano()
+}
+
+func histogramDataPointTime[N int64 | float64](dp metricdata.HistogramDataPoint[N]) int64 {
+	return dp.Time.UnixNano()
+}
+
+// normalizeSum returns s converted to the temporality cfg normalizes to. If
+// cfg does not normalize temporality, or s already has that temporality, s
+// is returned unchanged.
+func normalizeSum[N int64 | float64](s metricdata.Sum[N], cfg config) metricdata.Sum[N] {
+	switch {
+	case cfg.normalizeTemporality == 0 || s.Temporality == cfg.normalizeTemporality:
+	case cfg.normalizeTemporality == metricdata.CumulativeTemporality && s.Temporality == metricdata.DeltaTemporality:
+		s.DataPoints = AccumulateDelta(s.DataPoints)
+		s.Temporality = metricdata.CumulativeTemporality
+	case cfg.normalizeTemporality == metricdata.DeltaTemporality && s.Temporality == metricdata.CumulativeTemporality:
+		s.DataPoints = differenceCumulative(s.DataPoints)
+		s.Temporality = metricdata.DeltaTemporality
+	}
+	return s
+}
+
+// normalizeHistogram returns h converted to the temporality cfg normalizes
+// to. If cfg does not normalize temporality, or h already has that
+// temporality, h is returned unchanged.
+func normalizeHistogram[N int64 | float64](h metricdata.Histogram[N], cfg config) metricdata.Histogram[N] {
+	switch {
+	case cfg.normalizeTemporality == 0 || h.Temporality == cfg.normalizeTemporality:
+	case cfg.normalizeTemporality == metricdata.CumulativeTemporality && h.Temporality == metricdata.DeltaTemporality:
+		h.DataPoints = accumulateHistogramDelta(h.DataPoints)
+		h.Temporality = metricdata.CumulativeTemporality
+	case cfg.normalizeTemporality == metricdata.DeltaTemporality && h.Temporality == metricdata.CumulativeTemporality:
+		h.DataPoints = differenceHistogramCumulative(h.DataPoints)
+		h.Temporality = metricdata.DeltaTemporality
+	}
+	return h
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var (
+	t0 = startA
+	t1 = t0.Add(time.Second)
+	t2 = t1.Add(time.Second)
+	t3 = t2.Add(time.Second)
+
+	deltaSum = metricdata.Sum[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			// Out of order to verify series are accumulated by time.
+			{Attributes: attrA, StartTime: t1, Time: t2, Value: 2},
+			{Attributes: attrA, StartTime: t0, Time: t1, Value: 1},
+			{Attributes: attrB, StartTime: t0, Time: t1, Value: 10},
+			{Attributes: attrA, StartTime: t2, Time: t3, Value: 3},
+		},
+	}
+	cumulativeSum = metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: attrA, StartTime: t0, Time: t1, Value: 1},
+			{Attributes: attrA, StartTime: t0, Time: t2, Value: 3},
+			{Attributes: attrA, StartTime: t0, Time: t3, Value: 6},
+			{Attributes: attrB, StartTime: t0, Time: t1, Value: 10},
+		},
+	}
+
+	deltaHistogram = metricdata.Histogram[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[int64]{
+			{
+				Attributes:   attrA,
+				StartTime:    t0,
+				Time:         t1,
+				Count:        2,
+				Bounds:       []float64{1, 5},
+				BucketCounts: []uint64{1, 1, 0},
+				Min:          metricdata.NewExtrema[int64](1),
+				Max:          metricdata.NewExtrema[int64](3),
+				Sum:          4,
+			},
+			{
+				Attributes:   attrA,
+				StartTime:    t1,
+				Time:         t2,
+				Count:        1,
+				Bounds:       []float64{1, 5},
+				BucketCounts: []uint64{0, 0, 1},
+				Min:          metricdata.NewExtrema[int64](7),
+				Max:          metricdata.NewExtrema[int64](7),
+				Sum:          7,
+			},
+		},
+	}
+	cumulativeHistogram = metricdata.Histogram[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[int64]{
+			{
+				Attributes:   attrA,
+				StartTime:    t0,
+				Time:         t1,
+				Count:        2,
+				Bounds:       []float64{1, 5},
+				BucketCounts: []uint64{1, 1, 0},
+				Min:          metricdata.NewExtrema[int64](1),
+				Max:          metricdata.NewExtrema[int64](3),
+				Sum:          4,
+			},
+			{
+				Attributes:   attrA,
+				StartTime:    t0,
+				Time:         t2,
+				Count:        3,
+				Bounds:       []float64{1, 5},
+				BucketCounts: []uint64{1, 1, 1},
+				Min:          metricdata.NewExtrema[int64](1),
+				Max:          metricdata.NewExtrema[int64](7),
+				Sum:          11,
+			},
+		},
+	}
+)
+
+func TestAccumulateDelta(t *testing.T) {
+	in := append([]metricdata.DataPoint[int64](nil), deltaSum.DataPoints...)
+	got := AccumulateDelta(in)
+
+	want := []metricdata.DataPoint[int64]{
+		{Attributes: attrA, StartTime: t0, Time: t2, Value: 3},
+		{Attributes: attrA, StartTime: t0, Time: t1, Value: 1},
+		{Attributes: attrB, StartTime: t0, Time: t1, Value: 10},
+		{Attributes: attrA, StartTime: t0, Time: t3, Value: 6},
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, deltaSum.DataPoints, in, "input modified")
+	assert.Empty(t, AccumulateDelta[float64](nil))
+}
+
+func TestNormalizeTemporalitySum(t *testing.T) {
+	assert.NotEmpty(t, equalSums(cumulativeSum, deltaSum, newConfig(nil)))
+
+	cumulative := newConfig([]Option{NormalizeTemporality(metricdata.CumulativeTemporality)})
+	assert.Empty(t, equalSums(cumulativeSum, deltaSum, cumulative))
+	assert.Empty(t, equalSums(deltaSum, cumulativeSum, cumulative))
+	assert.True(t, eqSums(cumulativeSum, deltaSum, cumulative))
+
+	delta := newConfig([]Option{NormalizeTemporality(metricdata.DeltaTemporality)})
+	assert.Empty(t, equalSums(cumulativeSum, deltaSum, delta))
+	assert.True(t, eqSums(deltaSum, cumulativeSum, delta))
+
+	modified := cumulativeSum
+	modified.DataPoints = append([]metricdata.DataPoint[int64](nil), cumulativeSum.DataPoints...)
+	modified.DataPoints[2].Value = 7
+	assert.NotEmpty(t, equalSums(modified, deltaSum, cumulative))
+	assert.NotEmpty(t, equalSums(modified, deltaSum, delta))
+	assert.False(t, eqSums(modified, deltaSum, delta))
+}
+
+func TestNormalizeTemporalityHistogram(t *testing.T) {
+	assert.NotEmpty(t, equalHistograms(cumulativeHistogram, deltaHistogram, newConfig(nil)))
+
+	cumulative := newConfig([]Option{NormalizeTemporality(metricdata.CumulativeTemporality)})
+	assert.Empty(t, equalHistograms(cumulativeHistogram, deltaHistogram, cumulative))
+	assert.True(t, eqHistograms(deltaHistogram, cumulativeHistogram, cumulative))
+
+	// Min and Max of all but the first data point cannot be derived.
+	delta := newConfig([]Option{NormalizeTemporality(metricdata.DeltaTemporality)})
+	got := normalizeHistogram(cumulativeHistogram, delta)
+	want := deltaHistogram
+	want.DataPoints = append([]metricdata.HistogramDataPoint[int64](nil), deltaHistogram.DataPoints...)
+	want.DataPoints[1].Min = metricdata.Extrema[int64]{}
+	want.DataPoints[1].Max = metricdata.Extrema[int64]{}
+	assert.Equal(t, want, got)
+}
+
+func TestNormalizeTemporalityAssertEqual(t *testing.T) {
+	expected := metricdata.Metrics{Name: "sum", Data: cumulativeSum}
+	actual := metricdata.Metrics{Name: "sum", Data: deltaSum}
+
+	rT := new(recordingT)
+	assert.False(t, AssertEqual(rT, expected, actual))
+	assert.True(t, AssertEqual(t, expected, actual, NormalizeTemporality(metricdata.CumulativeTemporality)))
+	assert.True(t, EqualFast(expected, actual, NormalizeTemporality(metricdata.CumulativeTemporality)))
+}
@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import "sort"
+
+// OptionUsage records which ignore and tolerance options made a difference
+// to the result of comparisons. This can be used to find options that are
+// set but have no effect, like IgnoreExemplars for values without
+// exemplars.
+//
+// An option made a difference to a comparison if the comparison reports
+// other reasons without it. Options are identified by the name of the
+// function returning them, like "IgnoreExemplars" or "WithTolerance".
+//
+// The zero value is ready to use. An OptionUsage is not safe for concurrent
+// use.
+type OptionUsage struct {
+	set  map[string]int
+	used map[string]int
+}
+
+// RecordOptionUsage records the ignore and tolerance options of comparisons
+// in usage. The comparisons of AssertEqual, Equal, Explain, and Comparator,
+// and all other functions comparing Datatypes, are recorded. EqualFast and
+// the comparison of Aggregations are not recorded.
+//
+// Recording compares values again for each ignore and tolerance option set.
+// Only use it when auditing the options of a test suite.
+func RecordOptionUsage(usage *OptionUsage) Option {
+	return fnOption(func(cfg config) config {
+		cfg.usage = usage
+		return cfg
+	})
+}
+
+// Count returns the number of comparisons the option named name made a
+// difference to.
+func (u *OptionUsage) Count(name string) int {
+	return u.used[name]
+}
+
+// Unused returns the sorted names of the options that were set for a
+// recorded comparison but did not make a difference to any.
+func (u *OptionUsage) Unused() []string {
+	var names []string
+	for name := range u.set {
+		if u.used[name] == 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// record records the options of cfg that made a difference to the
+// comparison of expected and actual. The reasons are the result of the
+// comparison with all options of cfg.
+func (u *OptionUsage) record(expected, actual interface{}, cfg config, reasons []string) {
+	if u.set == nil {
+		u.set = make(map[string]int)
+		u.used = make(map[string]int)
+	}
+
+	cfg.usage = nil
+	for _, opt := range recordedOptions {
+		if !opt.isSet(cfg) {
+			continue
+		}
+		u.set[opt.name]++
+		if r, _ := equalIfaces(expected, actual, opt.unset(cfg)); !equalSlices(r, reasons) {
+			u.used[opt.name]++
+		}
+	}
+}
+
+// recordedOptions are the ignore and tolerance options an OptionUsage
+// records.
+var recordedOptions = []struct {
+	name  string
+	isSet func(config) bool
+	unset func(config) config
+}{
+	{
+		name:  "IgnoreTimestamp",
+		isSet: func(cfg config) bool { return cfg.ignoreTimestamp },
+		unset: func(cfg config) config { cfg.ignoreTimestamp = false; return cfg },
+	},
+	{
+		name:  "IgnoreZeroStartTime",
+		isSet: func(cfg config) bool { return cfg.ignoreZeroStartTime },
+		unset: func(cfg config) config { cfg.ignoreZeroStartTime = false; return cfg },
+	},
+	{
+		name:  "IgnoreExemplars",
+		isSet: func(cfg config) bool { return cfg.ignoreExemplars },
+		unset: func(cfg config) config { cfg.ignoreExemplars = false; return cfg },
+	},
+	{
+		name:  "IgnoreExemplarFilteredAttributes",
+		isSet: func(cfg config) bool { return cfg.ignoreExemplarFilteredAttributes },
+		unset: func(cfg config) config { cfg.ignoreExemplarFilteredAttributes = false; return cfg },
+	},
+	{
+		name:  "ExemplarSubset",
+		isSet: func(cfg config) bool { return cfg.exemplarSubset },
+		unset: func(cfg config) config { cfg.exemplarSubset = false; return cfg },
+	},
+	{
+		name:  "IgnoreValue",
+		isSet: func(cfg config) bool { return cfg.ignoreValue },
+		unset: func(cfg config) config { cfg.ignoreValue = false; return cfg },
+	},
+	{
+		name:  "IgnoreTemporality",
+		isSet: func(cfg config) bool { return cfg.ignoreTemporality },
+		unset: func(cfg config) config { cfg.ignoreTemporality = false; return cfg },
+	},
+	{
+		name:  "IgnoreMonotonicity",
+		isSet: func(cfg config) bool { return cfg.ignoreMonotonicity },
+		unset: func(cfg config) config { cfg.ignoreMonotonicity = false; return cfg },
+	},
+	{
+		name:  "IgnoreScopeVersion",
+		isSet: func(cfg config) bool { return cfg.ignoreScopeVersion },
+		unset: func(cfg config) config { cfg.ignoreScopeVersion = false; return cfg },
+	},
+	{
+		name:  "IgnoreHistogramSum",
+		isSet: func(cfg config) bool { return cfg.ignoreHistogramSum },
+		unset: func(cfg config) config { cfg.ignoreHistogramSum = false; return cfg },
+	},
+	{
+		name:  "IgnoreHistogramMinMax",
+		isSet: func(cfg config) bool { return cfg.ignoreHistogramMinMax },
+		unset: func(cfg config) config { cfg.ignoreHistogramMinMax = false; return cfg },
+	},
+	{
+		name:  "IgnoreTrailingZeroBuckets",
+		isSet: func(cfg config) bool { return cfg.ignoreTrailingZeroBuckets },
+		unset: func(cfg config) config { cfg.ignoreTrailingZeroBuckets = false; return cfg },
+	},
+	{
+		name:  "TreatZeroAsUnset",
+		isSet: func(cfg config) bool { return cfg.zeroAsUnset },
+		unset: func(cfg config) config { cfg.zeroAsUnset = false; return cfg },
+	},
+	{
+		name:  "MatchAttributeKeys",
+		isSet: func(cfg config) bool { return cfg.attributeKeys != nil },
+		unset: func(cfg config) config {
+			cfg.attributeKeys = nil
+			cfg.attributeFilter = newAttributeFilter(cfg)
+			return cfg
+		},
+	},
+	{
+		name:  "IgnoreAttributeKeys",
+		isSet: func(cfg config) bool { return cfg.ignoredAttributeKeys != nil },
+		unset: func(cfg config) config {
+			cfg.ignoredAttributeKeys = nil
+			cfg.attributeFilter = newAttributeFilter(cfg)
+			return cfg
+		},
+	},
+	{
+		name:  "WithResourceAttributeKeys",
+		isSet: func(cfg config) bool { return cfg.resourceAttributeKeys != nil },
+		unset: func(cfg config) config { cfg.resourceAttributeKeys = nil; return cfg },
+	},
+	{
+		name:  "OnlyMetrics",
+		isSet: func(cfg config) bool { return cfg.metricPatterns != nil },
+		unset: func(cfg config) config { cfg.metricPatterns = nil; return cfg },
+	},
+	{
+		name:  "WithTolerance",
+		isSet: func(cfg config) bool { return cfg.tolerance > 0 },
+		unset: func(cfg config) config { cfg.tolerance = 0; return cfg },
+	},
+	{
+		name:  "WithRelativeTolerance",
+		isSet: func(cfg config) bool { return cfg.relativeTolerance > 0 },
+		unset: func(cfg config) config { cfg.relativeTolerance = 0; return cfg },
+	},
+	{
+		name:  "WithBucketCountTolerance",
+		isSet: func(cfg config) bool { return cfg.bucketCountTolerance > 0 },
+		unset: func(cfg config) config { cfg.bucketCountTolerance = 0; return cfg },
+	},
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestRecordOptionUsage(t *testing.T) {
+	var usage OptionUsage
+	assert.Empty(t, usage.Unused())
+
+	noExemplars := dataPointInt64A
+	noExemplars.Exemplars = nil
+	later := noExemplars
+	later.Time = endB
+
+	opts := []Option{RecordOptionUsage(&usage), IgnoreTimestamp(), IgnoreExemplars(), WithTolerance(0.5)}
+	assert.True(t, AssertEqual(t, noExemplars, later, opts...))
+	assert.Equal(t, 1, usage.Count("IgnoreTimestamp"))
+	assert.Equal(t, 0, usage.Count("IgnoreExemplars"))
+	assert.Equal(t, []string{"IgnoreExemplars", "WithTolerance"}, usage.Unused())
+
+	// A failing comparison still records the options that changed its
+	// reasons.
+	other := dataPointInt64B
+	other.Exemplars = []metricdata.Exemplar[int64]{exemplarInt64A}
+	assert.False(t, AssertEqual(new(recordingT), noExemplars, other, opts...))
+	assert.Equal(t, 2, usage.Count("IgnoreTimestamp"))
+	assert.Equal(t, 1, usage.Count("IgnoreExemplars"))
+	assert.Equal(t, []string{"WithTolerance"}, usage.Unused())
+
+	c := NewComparator[metricdata.DataPoint[int64]](opts...)
+	assert.Empty(t, c.Compare(noExemplars, noExemplars))
+	assert.Equal(t, 2, usage.Count("IgnoreTimestamp"), "equal without the option")
+	assert.Equal(t, []string{"WithTolerance"}, usage.Unused())
+
+	assert.Empty(t, Explain(dataPointFloat64A, dataPointFloat64A, RecordOptionUsage(&usage)), "no options set")
+	assert.Equal(t, []string{"WithTolerance"}, usage.Unused())
+}
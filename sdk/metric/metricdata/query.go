@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata // import "go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+import (
+	"path"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// Query selects Metrics and their data points from a ResourceMetrics. The
+// zero value selects everything.
+type Query struct {
+	// Name is the pattern the names of selected Metrics need to match. The
+	// pattern syntax is the one of path.Match. An empty Name matches all
+	// names.
+	Name string
+	// Scope, if not nil, is used to select ScopeMetrics. Only ScopeMetrics
+	// with a Scope it returns true for are selected.
+	Scope func(instrumentation.Scope) bool
+	// Aggregation, if not nil, is used to select Metrics. Only Metrics with
+	// an Aggregation it returns true for are selected.
+	Aggregation func(Aggregation) bool
+	// Attributes, if not nil, is used to select data points. Only data
+	// points with attributes it returns true for are selected, and Metrics
+	// without any selected data points are not selected.
+	Attributes func(attribute.Set) bool
+}
+
+// Select returns a copy of rm only containing what q selects. ScopeMetrics
+// without any selected Metrics are not included.
+//
+// An error is returned if the Name of q is a malformed pattern.
+func (q Query) Select(rm ResourceMetrics) (ResourceMetrics, error) {
+	if _, err := path.Match(q.Name, ""); err != nil {
+		return ResourceMetrics{}, err
+	}
+
+	out := ResourceMetrics{Resource: rm.Resource}
+	for _, sm := range rm.ScopeMetrics {
+		if q.Scope != nil && !q.Scope(sm.Scope) {
+			continue
+		}
+
+		var metrics []Metrics
+		for _, m := range sm.Metrics {
+			if m, ok := q.selectMetrics(m); ok {
+				metrics = append(metrics, m.Clone())
+			}
+		}
+		if len(metrics) > 0 {
+			out.ScopeMetrics = append(out.ScopeMetrics, ScopeMetrics{
+				Scope:   sm.Scope,
+				Metrics: metrics,
+			})
+		}
+	}
+	return out, nil
+}
+
+// selectMetrics returns m with only the data points q selects, and if q
+// selects m.
+func (q Query) selectMetrics(m Metrics) (Metrics, bool) {
+	if q.Name != "" {
+		// The pattern was validated by Select.
+		if ok, _ := path.Match(q.Name, m.Name); !ok {
+			return m, false
+		}
+	}
+	if q.Aggregation != nil && !q.Aggregation(m.Data) {
+		return m, false
+	}
+	if q.Attributes == nil {
+		return m, true
+	}
+
+	var n int
+	switch a := m.Data.(type) {
+	case Gauge[int64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, dataPointAttrs[int64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case Gauge[float64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, dataPointAttrs[float64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case Sum[int64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, dataPointAttrs[int64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case Sum[float64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, dataPointAttrs[float64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case Histogram[int64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, histogramDataPointAttrs[int64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case Histogram[float64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, histogramDataPointAttrs[float64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case Summary[int64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, summaryDataPointAttrs[int64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case Summary[float64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, summaryDataPointAttrs[float64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case ExponentialHistogram[int64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, exponentialHistogramDataPointAttrs[int64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	case ExponentialHistogram[float64]:
+		a.DataPoints = selectDataPoints(a.DataPoints, exponentialHistogramDataPointAttrs[float64], q.Attributes)
+		m.Data, n = a, len(a.DataPoints)
+	}
+	return m, n > 0
+}
+
+// selectDataPoints returns the data points of dPts with attributes selected
+// by sel. The returned slice does not share memory with dPts.
+func selectDataPoints[T any](dPts []T, attrs func(T) attribute.Set, sel func(attribute.Set) bool) []T {
+	var out []T
+	for _, dp := range dPts {
+		if sel(attrs(dp)) {
+			out = append(out, dp)
+		}
+	}
+	return out
+}
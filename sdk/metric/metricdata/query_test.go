@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+func TestQuerySelect(t *testing.T) {
+	rm := newResourceMetrics()
+
+	got, err := Query{}.Select(rm)
+	require.NoError(t, err)
+	assert.Equal(t, rm, got)
+
+	got, err = Query{Name: "*histogram"}.Select(rm)
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	assert.Equal(t, []Metrics{rm.ScopeMetrics[0].Metrics[2], rm.ScopeMetrics[0].Metrics[3]}, got.ScopeMetrics[0].Metrics)
+
+	got, err = Query{Scope: func(s instrumentation.Scope) bool { return s.Name != "scope" }}.Select(rm)
+	require.NoError(t, err)
+	assert.Empty(t, got.ScopeMetrics)
+	assert.Equal(t, rm.Resource, got.Resource)
+
+	got, err = Query{Aggregation: func(a Aggregation) bool {
+		_, ok := a.(Sum[int64])
+		return ok
+	}}.Select(rm)
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	assert.Equal(t, []Metrics{rm.ScopeMetrics[0].Metrics[1]}, got.ScopeMetrics[0].Metrics)
+
+	_, err = Query{Name: "["}.Select(rm)
+	assert.ErrorIs(t, err, path.ErrBadPattern)
+}
+
+func TestQuerySelectAttributes(t *testing.T) {
+	attrA := attribute.NewSet(attribute.String("key", "A"))
+	attrB := attribute.NewSet(attribute.String("key", "B"))
+	rm := ResourceMetrics{ScopeMetrics: []ScopeMetrics{{
+		Metrics: []Metrics{
+			{Name: "sum", Data: Sum[float64]{
+				Temporality: DeltaTemporality,
+				DataPoints:  []DataPoint[float64]{{Attributes: attrA, Value: 1}, {Attributes: attrB, Value: 2}},
+			}},
+			{Name: "histogram", Data: Histogram[int64]{
+				DataPoints: []HistogramDataPoint[int64]{{Attributes: attrB}},
+			}},
+		},
+	}}}
+
+	q := Query{Attributes: func(s attribute.Set) bool {
+		v, _ := s.Value("key")
+		return v.AsString() == "A"
+	}}
+	got, err := q.Select(rm)
+	require.NoError(t, err)
+
+	want := []ScopeMetrics{{
+		Metrics: []Metrics{
+			{Name: "sum", Data: Sum[float64]{
+				Temporality: DeltaTemporality,
+				DataPoints:  []DataPoint[float64]{{Attributes: attrA, Value: 1}},
+			}},
+		},
+	}}
+	assert.Equal(t, want, got.ScopeMetrics)
+
+	// The selected copy does not share data points with rm.
+	got.ScopeMetrics[0].Metrics[0].Data.(Sum[float64]).DataPoints[0].Value = 100
+	assert.Equal(t, 1.0, rm.ScopeMetrics[0].Metrics[0].Data.(Sum[float64]).DataPoints[0].Value)
+}
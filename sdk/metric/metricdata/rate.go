@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata // import "go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// Rate is the rate of change of a Sum timeseries between two collections.
+type Rate struct {
+	// Scope is the Scope of the Metrics the timeseries belongs to.
+	Scope instrumentation.Scope
+	// Name is the name of the Metrics the timeseries belongs to.
+	Name string
+	// Attributes identify the timeseries.
+	Attributes attribute.Set
+	// PerSecond is the change of the value of the timeseries per second.
+	PerSecond float64
+}
+
+// Rates returns the rates of change of the cumulative Sum timeseries of
+// curr since prev. The values of prev need to be collected before the values
+// of curr from the same producer.
+//
+// A timeseries is reset if its StartTime changes, or if the value of a
+// monotonic Sum decreases. The rate of a reset timeseries, or a timeseries
+// not in prev, is computed since its StartTime. No rate is returned for a
+// timeseries without a time difference to compute its rate over.
+//
+// The rates are returned in the order of the timeseries in curr. Delta Sums
+// and all other Aggregations are ignored.
+func Rates(prev, curr ResourceMetrics) []Rate {
+	type key struct {
+		scope instrumentation.Scope
+		name  string
+		attrs attribute.Distinct
+	}
+	type point struct {
+		start, time time.Time
+		value       float64
+	}
+
+	prevPoints := make(map[key]point)
+	for _, sm := range prev.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			for _, dp := range cumulativeSumPoints(m.Data) {
+				k := key{sm.Scope, m.Name, dp.Attributes.Equivalent()}
+				prevPoints[k] = point{dp.StartTime, dp.Time, dp.Value}
+			}
+		}
+	}
+
+	var rates []Rate
+	for _, sm := range curr.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			monotonic := isMonotonic(m.Data)
+			for _, dp := range cumulativeSumPoints(m.Data) {
+				start, value := dp.StartTime, dp.Value
+				p, ok := prevPoints[key{sm.Scope, m.Name, dp.Attributes.Equivalent()}]
+				if ok && p.start.Equal(dp.StartTime) && !(monotonic && dp.Value < p.value) {
+					start, value = p.time, dp.Value-p.value
+				}
+
+				d := dp.Time.Sub(start)
+				if start.IsZero() || d <= 0 {
+					continue
+				}
+				rates = append(rates, Rate{
+					Scope:      sm.Scope,
+					Name:       m.Name,
+					Attributes: dp.Attributes,
+					PerSecond:  value / d.Seconds(),
+				})
+			}
+		}
+	}
+	return rates
+}
+
+// cumulativeSumPoints returns the data points of agg as float64 if it is a
+// cumulative Sum.
+func cumulativeSumPoints(agg Aggregation) []DataPoint[float64] {
+	switch a := agg.(type) {
+	case Sum[int64]:
+		if a.Temporality != CumulativeTemporality {
+			return nil
+		}
+		out := make([]DataPoint[float64], len(a.DataPoints))
+		for i, dp := range a.DataPoints {
+			out[i] = DataPoint[float64]{
+				Attributes: dp.Attributes,
+				StartTime:  dp.StartTime,
+				Time:       dp.Time,
+				Value:      float64(dp.Value),
+			}
+		}
+		return out
+	case Sum[float64]:
+		if a.Temporality != CumulativeTemporality {
+			return nil
+		}
+		return a.DataPoints
+	}
+	return nil
+}
+
+// isMonotonic returns if agg is a monotonic Sum.
+func isMonotonic(agg Aggregation) bool {
+	switch a := agg.(type) {
+	case Sum[int64]:
+		return a.IsMonotonic
+	case Sum[float64]:
+		return a.IsMonotonic
+	}
+	return false
+}
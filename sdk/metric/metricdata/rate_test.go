@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+func TestRates(t *testing.T) {
+	attrA := attribute.NewSet(attribute.String("key", "A"))
+	attrB := attribute.NewSet(attribute.String("key", "B"))
+	attrC := attribute.NewSet(attribute.String("key", "C"))
+	scope := instrumentation.Scope{Name: "scope"}
+	t0, t1, t3 := time.Unix(0, 0), time.Unix(10, 0), time.Unix(30, 0)
+
+	rm := func(dPts ...DataPoint[int64]) ResourceMetrics {
+		return ResourceMetrics{ScopeMetrics: []ScopeMetrics{{
+			Scope: scope,
+			Metrics: []Metrics{
+				{Name: "sum", Data: Sum[int64]{
+					Temporality: CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints:  dPts,
+				}},
+				{Name: "delta", Data: Sum[int64]{
+					Temporality: DeltaTemporality,
+					DataPoints:  dPts,
+				}},
+				{Name: "gauge", Data: Gauge[int64]{DataPoints: dPts}},
+			},
+		}}}
+	}
+
+	prev := rm(
+		DataPoint[int64]{Attributes: attrA, StartTime: t0, Time: t1, Value: 10},
+		DataPoint[int64]{Attributes: attrB, StartTime: t0, Time: t1, Value: 50},
+	)
+	curr := rm(
+		DataPoint[int64]{Attributes: attrA, StartTime: t0, Time: t3, Value: 50},
+		// Reset: the value decreased.
+		DataPoint[int64]{Attributes: attrB, StartTime: t0, Time: t3, Value: 15},
+		// Not in prev.
+		DataPoint[int64]{Attributes: attrC, StartTime: t1, Time: t3, Value: 40},
+	)
+
+	want := []Rate{
+		{Scope: scope, Name: "sum", Attributes: attrA, PerSecond: 2},
+		{Scope: scope, Name: "sum", Attributes: attrB, PerSecond: 0.5},
+		{Scope: scope, Name: "sum", Attributes: attrC, PerSecond: 2},
+	}
+	assert.Equal(t, want, Rates(prev, curr))
+
+	assert.Empty(t, Rates(curr, curr), "no time difference")
+	assert.Empty(t, Rates(ResourceMetrics{}, ResourceMetrics{}))
+}
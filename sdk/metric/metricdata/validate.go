@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata // import "go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Valid scale range of exponential histograms defined by the OpenTelemetry
+// specification.
+const (
+	minExponentialScale = -10
+	maxExponentialScale = 20
+)
+
+// Validate returns an error describing all the ways rm violates the
+// invariants the OpenTelemetry specification defines for metric data. It
+// returns nil if rm is valid.
+//
+// The invariants checked are:
+//
+//   - Data points of a Metrics have unique attributes.
+//   - StartTime of a data point is not after its Time.
+//   - Monotonic Sums do not contain negative values.
+//   - Histogram Bounds are increasing, BucketCounts has one more element
+//     than Bounds, and Count is the sum of BucketCounts.
+//   - Summary Min is not greater than Max.
+//   - ExponentialHistogram Scale is within [-10, 20], the bucket ranges fit
+//     the bucket index range, ZeroThreshold is not negative, and Count is the
+//     sum of ZeroCount and all bucket counts.
+//
+// This can be used by custom Producers and bridges to detect malformed data
+// before it is exported.
+func Validate(rm ResourceMetrics) error {
+	var errs []error
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			for _, err := range validateAggregation(m.Data) {
+				errs = append(errs, fmt.Errorf("scope %q metric %q: %w", sm.Scope.Name, m.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateAggregation(agg Aggregation) []error {
+	switch a := agg.(type) {
+	case Gauge[int64]:
+		return validateDataPoints(a.DataPoints, validateDataPoint[int64](false))
+	case Gauge[float64]:
+		return validateDataPoints(a.DataPoints, validateDataPoint[float64](false))
+	case Sum[int64]:
+		return validateDataPoints(a.DataPoints, validateDataPoint[int64](a.IsMonotonic))
+	case Sum[float64]:
+		return validateDataPoints(a.DataPoints, validateDataPoint[float64](a.IsMonotonic))
+	case Histogram[int64]:
+		return validateDataPoints(a.DataPoints, validateHistogramDataPoint[int64])
+	case Histogram[float64]:
+		return validateDataPoints(a.DataPoints, validateHistogramDataPoint[float64])
+	case Summary[int64]:
+		return validateDataPoints(a.DataPoints, validateSummaryDataPoint[int64])
+	case Summary[float64]:
+		return validateDataPoints(a.DataPoints, validateSummaryDataPoint[float64])
+	case ExponentialHistogram[int64]:
+		return validateDataPoints(a.DataPoints, validateExponentialHistogramDataPoint[int64])
+	case ExponentialHistogram[float64]:
+		return validateDataPoints(a.DataPoints, validateExponentialHistogramDataPoint[float64])
+	}
+	return nil
+}
+
+// dataPoint is implemented by all data point types.
+type dataPoint interface {
+	DataPoint[int64] | DataPoint[float64] |
+		HistogramDataPoint[int64] | HistogramDataPoint[float64] |
+		SummaryDataPoint[int64] | SummaryDataPoint[float64] |
+		ExponentialHistogramDataPoint[int64] | ExponentialHistogramDataPoint[float64]
+}
+
+// validateDataPoints returns the errors of validating each of dPts with
+// validate, and an error for each data point with duplicate attributes.
+func validateDataPoints[T dataPoint](dPts []T, validate func(T) []error) []error {
+	var errs []error
+	seen := make(map[attribute.Distinct]struct{}, len(dPts))
+	for _, dp := range dPts {
+		attrs, start, t := dataPointIdentity(dp)
+		name := attrs.Encoded(attribute.DefaultEncoder())
+		if _, ok := seen[attrs.Equivalent()]; ok {
+			errs = append(errs, fmt.Errorf("data point {%s}: duplicate attributes", name))
+		}
+		seen[attrs.Equivalent()] = struct{}{}
+
+		if !start.IsZero() && !t.IsZero() && start.After(t) {
+			errs = append(errs, fmt.Errorf("data point {%s}: StartTime %s after Time %s", name, start, t))
+		}
+		for _, err := range validate(dp) {
+			errs = append(errs, fmt.Errorf("data point {%s}: %w", name, err))
+		}
+	}
+	return errs
+}
+
+// dataPointIdentity returns the attributes and timestamps of dp.
+func dataPointIdentity[T dataPoint](dp T) (*attribute.Set, time.Time, time.Time) {
+	switch dp := any(dp).(type) {
+	case DataPoint[int64]:
+		return &dp.Attributes, dp.StartTime, dp.Time
+	case DataPoint[float64]:
+		return &dp.Attributes, dp.StartTime, dp.Time
+	case HistogramDataPoint[int64]:
+		return &dp.Attributes, dp.StartTime, dp.Time
+	case HistogramDataPoint[float64]:
+		return &dp.Attributes, dp.StartTime, dp.Time
+	case SummaryDataPoint[int64]:
+		return &dp.Attributes, dp.StartTime, dp.Time
+	case SummaryDataPoint[float64]:
+		return &dp.Attributes, dp.StartTime, dp.Time
+	case ExponentialHistogramDataPoint[int64]:
+		return &dp.Attributes, dp.StartTime, dp.Time
+	case ExponentialHistogramDataPoint[float64]:
+		return &dp.Attributes, dp.StartTime, dp.Time
+	}
+	return attribute.EmptySet(), time.Time{}, time.Time{}
+}
+
+func validateDataPoint[N int64 | float64](monotonic bool) func(DataPoint[N]) []error {
+	return func(dp DataPoint[N]) []error {
+		if monotonic && dp.Value < 0 {
+			return []error{fmt.Errorf("negative Value %v of monotonic Sum", dp.Value)}
+		}
+		return nil
+	}
+}
+
+func validateHistogramDataPoint[N int64 | float64](dp HistogramDataPoint[N]) (errs []error) {
+	for i := 1; i < len(dp.Bounds); i++ {
+		if !(dp.Bounds[i-1] < dp.Bounds[i]) {
+			errs = append(errs, fmt.Errorf("invalid Bounds %v: not increasing", dp.Bounds))
+			break
+		}
+	}
+	if len(dp.BucketCounts) != len(dp.Bounds)+1 {
+		errs = append(errs, fmt.Errorf("%d BucketCounts for %d Bounds, want %d", len(dp.BucketCounts), len(dp.Bounds), len(dp.Bounds)+1))
+	}
+	if sum := sumCounts(dp.BucketCounts); sum != dp.Count {
+		errs = append(errs, fmt.Errorf("invalid Count %d: sum of BucketCounts is %d", dp.Count, sum))
+	}
+	return errs
+}
+
+func validateSummaryDataPoint[N int64 | float64](dp SummaryDataPoint[N]) []error {
+	minV, minOK := dp.Min.Value()
+	maxV, maxOK := dp.Max.Value()
+	if minOK && maxOK && minV > maxV {
+		return []error{fmt.Errorf("invalid Min %v: greater than Max %v", minV, maxV)}
+	}
+	return nil
+}
+
+func validateExponentialHistogramDataPoint[N int64 | float64](dp ExponentialHistogramDataPoint[N]) (errs []error) {
+	if dp.Scale < minExponentialScale || dp.Scale > maxExponentialScale {
+		errs = append(errs, fmt.Errorf("invalid Scale %d: outside of [%d, %d]", dp.Scale, minExponentialScale, maxExponentialScale))
+	}
+	if dp.ZeroThreshold < 0 || math.IsNaN(dp.ZeroThreshold) {
+		errs = append(errs, fmt.Errorf("invalid ZeroThreshold %v", dp.ZeroThreshold))
+	}
+	for _, b := range []struct {
+		name   string
+		bucket ExponentialBucket
+	}{{"PositiveBucket", dp.PositiveBucket}, {"NegativeBucket", dp.NegativeBucket}} {
+		if int64(b.bucket.Offset)+int64(len(b.bucket.Counts)) > math.MaxInt32+1 {
+			errs = append(errs, fmt.Errorf("invalid %s: Offset %d with %d Counts overflows the bucket index range", b.name, b.bucket.Offset, len(b.bucket.Counts)))
+		}
+	}
+	sum := dp.ZeroCount + sumCounts(dp.PositiveBucket.Counts) + sumCounts(dp.NegativeBucket.Counts)
+	if sum != dp.Count {
+		errs = append(errs, fmt.Errorf("invalid Count %d: sum of ZeroCount and bucket counts is %d", dp.Count, sum))
+	}
+	return errs
+}
+
+func sumCounts(counts []uint64) (sum uint64) {
+	for _, c := range counts {
+		sum += c
+	}
+	return sum
+}
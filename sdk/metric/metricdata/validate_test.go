@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricdata
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(ResourceMetrics{}))
+
+	rm := newResourceMetrics()
+	require.NoError(t, Validate(rm))
+}
+
+func TestValidateErrors(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("key", "A"))
+	start, end := time.Unix(2, 0), time.Unix(1, 0)
+
+	tests := []struct {
+		name string
+		data Aggregation
+		want []string
+	}{
+		{
+			name: "DuplicateAttributes",
+			data: Gauge[int64]{DataPoints: []DataPoint[int64]{{Attributes: attrs}, {Attributes: attrs}}},
+			want: []string{"duplicate attributes"},
+		},
+		{
+			name: "StartTimeAfterTime",
+			data: Gauge[float64]{DataPoints: []DataPoint[float64]{{StartTime: start, Time: end}}},
+			want: []string{"StartTime"},
+		},
+		{
+			name: "NegativeMonotonicSum",
+			data: Sum[int64]{IsMonotonic: true, DataPoints: []DataPoint[int64]{{Value: -1}}},
+			want: []string{"negative Value -1 of monotonic Sum"},
+		},
+		{
+			name: "Histogram",
+			data: Histogram[float64]{DataPoints: []HistogramDataPoint[float64]{{
+				Count:        1,
+				Bounds:       []float64{2, 1},
+				BucketCounts: []uint64{1, 1},
+			}}},
+			want: []string{
+				"invalid Bounds [2 1]: not increasing",
+				"2 BucketCounts for 2 Bounds, want 3",
+				"invalid Count 1: sum of BucketCounts is 2",
+			},
+		},
+		{
+			name: "Summary",
+			data: Summary[int64]{DataPoints: []SummaryDataPoint[int64]{{
+				Count: 2,
+				Min:   NewExtrema[int64](3),
+				Max:   NewExtrema[int64](1),
+			}}},
+			want: []string{"invalid Min 3: greater than Max 1"},
+		},
+		{
+			name: "ExponentialHistogram",
+			data: ExponentialHistogram[int64]{DataPoints: []ExponentialHistogramDataPoint[int64]{{
+				Count:          1,
+				Scale:          21,
+				ZeroThreshold:  math.NaN(),
+				PositiveBucket: ExponentialBucket{Offset: math.MaxInt32, Counts: []uint64{1, 1}},
+			}}},
+			want: []string{
+				"invalid Scale 21: outside of [-10, 20]",
+				"invalid ZeroThreshold NaN",
+				"invalid PositiveBucket",
+				"invalid Count 1: sum of ZeroCount and bucket counts is 2",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Validate(ResourceMetrics{ScopeMetrics: []ScopeMetrics{{
+				Metrics: []Metrics{{Name: "m", Data: test.data}},
+			}}})
+			require.Error(t, err)
+
+			var joined interface{ Unwrap() []error }
+			require.True(t, errors.As(err, &joined))
+			assert.Len(t, joined.Unwrap(), len(test.want))
+			for _, want := range test.want {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
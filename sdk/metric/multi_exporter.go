@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// multiExporter is an Exporter that exports metric data to several
+// Exporters.
+type multiExporter struct {
+	exporters []Exporter
+	// converters holds a TemporalityConverter for each Temporality, for each
+	// of the exporters.
+	converters []map[metricdata.Temporality]*metricdata.TemporalityConverter
+}
+
+// Compile time check multiExporter implements Exporter.
+var _ Exporter = (*multiExporter)(nil)
+
+// NewMultiExporter returns an Exporter that exports metric data to all
+// exporters. This allows one Reader to collect metric data once for several
+// exporters, and each exporter receives the same snapshot of metric data.
+//
+// The metric data is exported to each of the exporters, even if exporting to
+// another one fails. All errors are combined into the returned error.
+//
+// If the exporters do not agree on the Temporality of an instrument kind,
+// the returned Exporter uses CumulativeTemporality for it and converts the
+// Sums and Histograms to the Temporality each exporter uses for the
+// instrument kind of their stream. ExponentialHistograms are not converted.
+//
+// The instrument kind of the streams is known when the returned Exporter is
+// used by a PeriodicReader. Otherwise, e.g. for metric data of external
+// Producers or when Export is called directly, the data is only converted if
+// its Temporality is not the one an exporter uses for any instrument kind that
+// can produce it.
+//
+// The Aggregation used for an instrument kind is the one of the first
+// exporter.
+func NewMultiExporter(exporters ...Exporter) Exporter {
+	m := &multiExporter{
+		exporters:  exporters,
+		converters: make([]map[metricdata.Temporality]*metricdata.TemporalityConverter, len(exporters)),
+	}
+	for i := range m.converters {
+		m.converters[i] = map[metricdata.Temporality]*metricdata.TemporalityConverter{
+			metricdata.CumulativeTemporality: metricdata.NewTemporalityConverter(metricdata.CumulativeTemporality),
+			metricdata.DeltaTemporality:      metricdata.NewTemporalityConverter(metricdata.DeltaTemporality),
+		}
+	}
+	return m
+}
+
+// Temporality returns the Temporality all exporters of m use for kind, or
+// CumulativeTemporality if they do not agree.
+func (m *multiExporter) Temporality(kind InstrumentKind) metricdata.Temporality {
+	if len(m.exporters) == 0 {
+		return DefaultTemporalitySelector(kind)
+	}
+	t := m.exporters[0].Temporality(kind)
+	for _, e := range m.exporters[1:] {
+		if e.Temporality(kind) != t {
+			return metricdata.CumulativeTemporality
+		}
+	}
+	return t
+}
+
+// Aggregation returns the Aggregation the first exporter of m uses for kind.
+func (m *multiExporter) Aggregation(kind InstrumentKind) Aggregation {
+	if len(m.exporters) == 0 {
+		return DefaultAggregationSelector(kind)
+	}
+	return m.exporters[0].Aggregation(kind)
+}
+
+// Export exports rm to all exporters of m, each with the Temporality it uses.
+func (m *multiExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	kinds := streamKinds(ctx)
+	var errs []error
+	for i, e := range m.exporters {
+		out := m.reconcile(i, kinds, rm)
+		if err := e.Export(ctx, out); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return unifyErrors(errs)
+}
+
+// reconcile returns rm with the Sums and Histograms converted to the
+// Temporality the exporter at index i uses. The InstrumentKind of the streams
+// of rm is looked up in kinds. If nothing needs to be converted, rm itself is
+// returned.
+func (m *multiExporter) reconcile(i int, kinds map[streamID]InstrumentKind, rm *metricdata.ResourceMetrics) *metricdata.ResourceMetrics {
+	e := m.exporters[i]
+	var out *metricdata.ResourceMetrics
+	for j, sm := range rm.ScopeMetrics {
+		for k, metric := range sm.Metrics {
+			have, ok := convertibleTemporality(metric.Data)
+			if !ok {
+				continue
+			}
+			var want metricdata.Temporality
+			if kind, ok := kinds[streamID{scope: sm.Scope, name: metric.Name}]; ok {
+				want = e.Temporality(kind)
+			} else {
+				want = inferTemporality(e, metric.Data, have)
+			}
+			if want == have {
+				continue
+			}
+
+			if out == nil {
+				c := rm.Clone()
+				out = &c
+			}
+			// Convert the Metrics on its own, its timeseries are still
+			// identified by the Resource, Scope, and name.
+			single := metricdata.ResourceMetrics{
+				Resource: rm.Resource,
+				ScopeMetrics: []metricdata.ScopeMetrics{{
+					Scope:   sm.Scope,
+					Metrics: []metricdata.Metrics{metric},
+				}},
+			}
+			converted := m.converters[i][want].Convert(single)
+			out.ScopeMetrics[j].Metrics[k] = converted.ScopeMetrics[0].Metrics[0]
+		}
+	}
+	if out == nil {
+		return rm
+	}
+	return out
+}
+
+// convertibleTemporality returns the Temporality of a Sum or Histogram agg,
+// and true. If agg is not a Sum or Histogram false is returned.
+func convertibleTemporality(agg metricdata.Aggregation) (metricdata.Temporality, bool) {
+	switch a := agg.(type) {
+	case metricdata.Sum[int64]:
+		return a.Temporality, true
+	case metricdata.Sum[float64]:
+		return a.Temporality, true
+	case metricdata.Histogram[int64]:
+		return a.Temporality, true
+	case metricdata.Histogram[float64]:
+		return a.Temporality, true
+	}
+	return 0, false
+}
+
+// inferTemporality returns have if e uses it for any InstrumentKind that can
+// produce agg. Otherwise, the Temporality e uses for these InstrumentKinds
+// is returned.
+func inferTemporality(e Exporter, agg metricdata.Aggregation, have metricdata.Temporality) metricdata.Temporality {
+	var kinds []InstrumentKind
+	switch a := agg.(type) {
+	case metricdata.Sum[int64]:
+		kinds = sumKinds(a.IsMonotonic)
+	case metricdata.Sum[float64]:
+		kinds = sumKinds(a.IsMonotonic)
+	default:
+		kinds = []InstrumentKind{
+			InstrumentKindCounter,
+			InstrumentKindUpDownCounter,
+			InstrumentKindHistogram,
+			InstrumentKindObservableCounter,
+			InstrumentKindObservableUpDownCounter,
+			InstrumentKindObservableGauge,
+		}
+	}
+	for _, kind := range kinds {
+		if e.Temporality(kind) == have {
+			return have
+		}
+	}
+	return e.Temporality(kinds[0])
+}
+
+// sumKinds returns the InstrumentKinds that produce Sums.
+func sumKinds(monotonic bool) []InstrumentKind {
+	if monotonic {
+		return []InstrumentKind{InstrumentKindCounter, InstrumentKindHistogram, InstrumentKindObservableCounter}
+	}
+	return []InstrumentKind{InstrumentKindUpDownCounter, InstrumentKindObservableUpDownCounter}
+}
+
+// streamKindsKey is the context key of the InstrumentKinds of the streams
+// produced during a collection.
+type streamKindsKey struct{}
+
+// streamID identifies a stream produced during a collection.
+type streamID struct {
+	scope instrumentation.Scope
+	name  string
+}
+
+// withStreamKinds returns a copy of ctx that records the InstrumentKind of
+// the streams produced with it.
+func withStreamKinds(ctx context.Context) context.Context {
+	return context.WithValue(ctx, streamKindsKey{}, make(map[streamID]InstrumentKind))
+}
+
+// streamKinds returns the InstrumentKinds of the streams produced with ctx,
+// or nil if they are not recorded.
+func streamKinds(ctx context.Context) map[streamID]InstrumentKind {
+	kinds, _ := ctx.Value(streamKindsKey{}).(map[streamID]InstrumentKind)
+	return kinds
+}
+
+// ForceFlush flushes all exporters of m.
+func (m *multiExporter) ForceFlush(ctx context.Context) error {
+	var errs []error
+	for _, e := range m.exporters {
+		if err := e.ForceFlush(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return unifyErrors(errs)
+}
+
+// Shutdown shuts down all exporters of m.
+func (m *multiExporter) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, e := range m.exporters {
+		if err := e.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return unifyErrors(errs)
+}
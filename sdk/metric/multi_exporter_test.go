@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func deltaSelector(InstrumentKind) metricdata.Temporality {
+	return metricdata.DeltaTemporality
+}
+
+func TestMultiExporterTemporality(t *testing.T) {
+	cumulative := &fnExporter{}
+	delta := &fnExporter{temporalityFunc: deltaSelector}
+
+	kind := InstrumentKindCounter
+	assert.Equal(t, metricdata.DeltaTemporality, NewMultiExporter(delta, delta).Temporality(kind))
+	assert.Equal(t, metricdata.CumulativeTemporality, NewMultiExporter(cumulative, cumulative).Temporality(kind))
+	assert.Equal(t, metricdata.CumulativeTemporality, NewMultiExporter(delta, cumulative).Temporality(kind))
+	assert.Equal(t, metricdata.CumulativeTemporality, NewMultiExporter().Temporality(kind))
+}
+
+func TestMultiExporterExport(t *testing.T) {
+	var gotCumulative, gotDelta []int64
+	value := func(rm *metricdata.ResourceMetrics) int64 {
+		return rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64]).DataPoints[0].Value
+	}
+	cumulative := &fnExporter{
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			gotCumulative = append(gotCumulative, value(rm))
+			return assert.AnError
+		},
+	}
+	delta := &fnExporter{
+		temporalityFunc: deltaSelector,
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			gotDelta = append(gotDelta, value(rm))
+			return nil
+		},
+	}
+	exp := NewMultiExporter(cumulative, delta)
+
+	start := time.Now()
+	rm := func(v int64, t time.Time) *metricdata.ResourceMetrics {
+		return &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "multi"},
+			Metrics: []metricdata.Metrics{{
+				Name: "counter",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{{
+						StartTime: start,
+						Time:      t,
+						Value:     v,
+					}},
+				},
+			}},
+		}}}
+	}
+
+	ctx := context.Background()
+	first := rm(5, start.Add(time.Second))
+	assert.ErrorIs(t, exp.Export(ctx, first), assert.AnError)
+	assert.ErrorIs(t, exp.Export(ctx, rm(12, start.Add(2*time.Second))), assert.AnError)
+
+	assert.Equal(t, []int64{5, 12}, gotCumulative)
+	assert.Equal(t, []int64{5, 7}, gotDelta, "export to other exporters continues after an error")
+	assert.Equal(t, int64(5), value(first), "exported ResourceMetrics modified")
+}
+
+func TestMultiExporterForceFlushShutdown(t *testing.T) {
+	var flushed, shutdown int
+	newExp := func(err error) Exporter {
+		return &fnExporter{
+			flushFunc: func(context.Context) error {
+				flushed++
+				return err
+			},
+			shutdownFunc: func(context.Context) error {
+				shutdown++
+				return err
+			},
+		}
+	}
+	exp := NewMultiExporter(newExp(assert.AnError), newExp(nil))
+
+	ctx := context.Background()
+	assert.ErrorIs(t, exp.ForceFlush(ctx), assert.AnError)
+	assert.ErrorIs(t, exp.Shutdown(ctx), assert.AnError)
+	assert.Equal(t, 2, flushed)
+	assert.Equal(t, 2, shutdown)
+}
+
+func TestMultiExporterWithPeriodicReader(t *testing.T) {
+	var got []metricdata.Temporality
+	newExp := func(selector TemporalitySelector) Exporter {
+		return &fnExporter{
+			temporalityFunc: selector,
+			exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+				sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+				got = append(got, sum.Temporality)
+				return nil
+			},
+		}
+	}
+	r := NewPeriodicReader(NewMultiExporter(newExp(nil), newExp(deltaSelector)))
+	mp := NewMeterProvider(WithReader(r))
+
+	ctx := context.Background()
+	c, err := mp.Meter("multi").Int64Counter("counter")
+	require.NoError(t, err)
+	c.Add(ctx, 1)
+
+	require.NoError(t, mp.Shutdown(ctx))
+	assert.Equal(t, []metricdata.Temporality{
+		metricdata.CumulativeTemporality,
+		metricdata.DeltaTemporality,
+	}, got)
+}
+
+// lowMemorySelector uses DeltaTemporality for synchronous Counters and
+// Histograms, like the "lowmemory" temporality preference of OTLP exporters.
+func lowMemorySelector(ik InstrumentKind) metricdata.Temporality {
+	switch ik {
+	case InstrumentKindCounter, InstrumentKindHistogram:
+		return metricdata.DeltaTemporality
+	}
+	return metricdata.CumulativeTemporality
+}
+
+func TestMultiExporterLowMemoryWithPeriodicReader(t *testing.T) {
+	got := map[string][]metricdata.Temporality{}
+	newExp := func(name string, selector TemporalitySelector) Exporter {
+		return &fnExporter{
+			temporalityFunc: selector,
+			exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+				for _, m := range rm.ScopeMetrics[0].Metrics {
+					sum := m.Data.(metricdata.Sum[int64])
+					got[name+"/"+m.Name] = append(got[name+"/"+m.Name], sum.Temporality)
+				}
+				return nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		exporters []Exporter
+		want      map[string][]metricdata.Temporality
+	}{
+		{
+			name:      "Single",
+			exporters: []Exporter{newExp("lowmemory", lowMemorySelector)},
+			want: map[string][]metricdata.Temporality{
+				"lowmemory/counter":    {metricdata.DeltaTemporality},
+				"lowmemory/observable": {metricdata.CumulativeTemporality},
+			},
+		},
+		{
+			name: "Mixed",
+			exporters: []Exporter{
+				newExp("lowmemory", lowMemorySelector),
+				newExp("cumulative", nil),
+			},
+			want: map[string][]metricdata.Temporality{
+				"lowmemory/counter":     {metricdata.DeltaTemporality},
+				"lowmemory/observable":  {metricdata.CumulativeTemporality},
+				"cumulative/counter":    {metricdata.CumulativeTemporality},
+				"cumulative/observable": {metricdata.CumulativeTemporality},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got = map[string][]metricdata.Temporality{}
+			r := NewPeriodicReader(NewMultiExporter(test.exporters...))
+			mp := NewMeterProvider(WithReader(r))
+
+			ctx := context.Background()
+			meter := mp.Meter("multi")
+			c, err := meter.Int64Counter("counter")
+			require.NoError(t, err)
+			c.Add(ctx, 1)
+			_, err = meter.Int64ObservableCounter("observable", api.WithInt64Callback(
+				func(_ context.Context, o api.Int64Observer) error {
+					o.Observe(1)
+					return nil
+				},
+			))
+			require.NoError(t, err)
+
+			require.NoError(t, mp.Shutdown(ctx))
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestMultiExporterLowMemoryExportObservableCounter(t *testing.T) {
+	var got []metricdata.Temporality
+	exp := NewMultiExporter(&fnExporter{
+		temporalityFunc: lowMemorySelector,
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+			got = append(got, sum.Temporality)
+			return nil
+		},
+	})
+
+	// Without a PeriodicReader the instrument kind is unknown. The cumulative
+	// data could be produced by an ObservableCounter and is not converted.
+	rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{
+		Scope: instrumentation.Scope{Name: "multi"},
+		Metrics: []metricdata.Metrics{{
+			Name: "observable",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+			},
+		}},
+	}}}
+	require.NoError(t, exp.Export(context.Background(), rm))
+	assert.Equal(t, []metricdata.Temporality{metricdata.CumulativeTemporality}, got)
+}
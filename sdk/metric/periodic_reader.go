@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -35,9 +36,13 @@ const (
 
 // periodicReaderConfig contains configuration options for a PeriodicReader.
 type periodicReaderConfig struct {
-	interval  time.Duration
-	timeout   time.Duration
-	producers []Producer
+	interval     time.Duration
+	jitter       float64
+	timeout      time.Duration
+	flushTimeout time.Duration
+	batchSize    int
+	producers    []Producer
+	keepLast     bool
 }
 
 // newPeriodicReaderConfig returns a periodicReaderConfig configured with
@@ -87,6 +92,25 @@ func WithTimeout(d time.Duration) PeriodicReaderOption {
 	})
 }
 
+// WithFlushTimeout configures the maximum time ForceFlush of a PeriodicReader
+// can take. Unlike WithTimeout, it also bounds ForceFlush if the user passed
+// context has a deadline, the earlier of both is used. This ensures one slow
+// PeriodicReader does not consume the entire deadline passed to the
+// ForceFlush of a MeterProvider.
+//
+// If this option is not used or d is less than or equal to zero, ForceFlush
+// is only bounded by the user passed context or the timeout set with
+// WithTimeout.
+func WithFlushTimeout(d time.Duration) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		if d <= 0 {
+			return conf
+		}
+		conf.flushTimeout = d
+		return conf
+	})
+}
+
 // WithInterval configures the intervening time between exports for a
 // PeriodicReader.
 //
@@ -105,6 +129,53 @@ func WithInterval(d time.Duration) PeriodicReaderOption {
 	})
 }
 
+// WithIntervalJitter configures a PeriodicReader to randomly vary the time
+// between exports by up to fraction of the interval in either direction. A new
+// random variation is chosen for every cycle, so processes started at the same
+// time do not keep collecting and exporting at the same instant.
+//
+// If this option is not used or fraction is not within (0, 1), the interval is
+// not varied.
+func WithIntervalJitter(fraction float64) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		if !(fraction > 0 && fraction < 1) {
+			return conf
+		}
+		conf.jitter = fraction
+		return conf
+	})
+}
+
+// WithExportBatchSize configures a PeriodicReader to collect and export
+// metric data in batches of at most n Metrics, instead of collecting all
+// metric data before exporting it at once. The aggregation of an instrument
+// is only computed when it is added to a batch, and each batch is exported
+// once it is full. This bounds the memory used by a collection when the SDK
+// holds a large number of timeseries.
+//
+// The Exporter is called once per batch, and a collection is not exported
+// atomically: if exporting a batch or an instrument callback fails, the
+// remaining batches of that collection are still exported and the errors are
+// returned once all batches are exported. The aggregations are computed while
+// the collection is exported, so measurements made during the export can be
+// included in the later batches of that collection. Other collections of the
+// Reader, including calls to Collect, wait until the export is done.
+//
+// The collection kept with WithLastCollection is not updated by batched
+// collections.
+//
+// If this option is not used or n is less than or equal to zero, all metric
+// data is exported in a single batch.
+func WithExportBatchSize(n int) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		if n <= 0 {
+			return conf
+		}
+		conf.batchSize = n
+		return conf
+	})
+}
+
 // NewPeriodicReader returns a Reader that collects and exports metric data to
 // the exporter at a defined interval. By default, the returned Reader will
 // collect and export data every 60 seconds, and will cancel any attempts that
@@ -118,12 +189,16 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 	conf := newPeriodicReaderConfig(options)
 	ctx, cancel := context.WithCancel(context.Background())
 	r := &PeriodicReader{
-		interval: conf.interval,
-		timeout:  conf.timeout,
-		exporter: exporter,
-		flushCh:  make(chan chan error),
-		cancel:   cancel,
-		done:     make(chan struct{}),
+		interval:     conf.interval,
+		jitter:       conf.jitter,
+		timeout:      conf.timeout,
+		flushTimeout: conf.flushTimeout,
+		batchSize:    conf.batchSize,
+		exporter:     exporter,
+		flushCh:      make(chan chan error),
+		cancel:       cancel,
+		done:         make(chan struct{}),
+		last:         lastCollection{enabled: conf.keepLast},
 		rmPool: sync.Pool{
 			New: func() interface{} {
 				return &metricdata.ResourceMetrics{}
@@ -132,9 +207,10 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 	}
 	r.externalProducers.Store(conf.producers)
 
+	ticker := newTicker(r.jittered(conf.interval))
 	go func() {
 		defer func() { close(r.done) }()
-		r.run(ctx, conf.interval)
+		r.run(ctx, ticker, conf.interval)
 	}()
 
 	return r
@@ -149,16 +225,21 @@ type PeriodicReader struct {
 	isShutdown        bool
 	externalProducers atomic.Value
 
-	interval time.Duration
-	timeout  time.Duration
-	exporter Exporter
-	flushCh  chan chan error
+	interval     time.Duration
+	jitter       float64
+	timeout      time.Duration
+	flushTimeout time.Duration
+	batchSize    int
+	exporter     Exporter
+	flushCh      chan chan error
 
 	done         chan struct{}
 	cancel       context.CancelFunc
 	shutdownOnce sync.Once
 
 	rmPool sync.Pool
+
+	last lastCollection
 }
 
 // Compile time check the periodicReader implements Reader and is comparable.
@@ -167,10 +248,13 @@ var _ = map[Reader]struct{}{&PeriodicReader{}: {}}
 // newTicker allows testing override.
 var newTicker = time.NewTicker
 
-// run continuously collects and exports metric data at the specified
-// interval. This will run until ctx is canceled or times out.
-func (r *PeriodicReader) run(ctx context.Context, interval time.Duration) {
-	ticker := newTicker(interval)
+// randFloat64 allows testing override.
+var randFloat64 = rand.Float64
+
+// run continuously collects and exports metric data each time ticker ticks,
+// resetting it to the specified interval. This will run until ctx is canceled
+// or times out.
+func (r *PeriodicReader) run(ctx context.Context, ticker *time.Ticker, interval time.Duration) {
 	defer ticker.Stop()
 
 	for {
@@ -180,19 +264,40 @@ func (r *PeriodicReader) run(ctx context.Context, interval time.Duration) {
 			if err != nil {
 				otel.Handle(err)
 			}
+			if r.jitter > 0 {
+				ticker.Reset(r.jittered(interval))
+			}
 		case errCh := <-r.flushCh:
 			errCh <- r.collectAndExport(ctx)
-			ticker.Reset(interval)
+			ticker.Reset(r.jittered(interval))
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// jittered returns interval randomly varied by up to the jitter fraction of r
+// in either direction.
+func (r *PeriodicReader) jittered(interval time.Duration) time.Duration {
+	if r.jitter <= 0 {
+		return interval
+	}
+	delta := (2*randFloat64() - 1) * r.jitter * float64(interval)
+	if d := interval + time.Duration(delta); d > 0 {
+		return d
+	}
+	return interval
+}
+
 // register registers p as the producer of this reader.
 func (r *PeriodicReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
-	if !r.sdkProducer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
+	ph := produceHolder{
+		produce:         p.produce,
+		produceMatching: p.produceMatching,
+		produceBatches:  p.produceBatches,
+	}
+	if !r.sdkProducer.CompareAndSwap(nil, ph) {
 		msg := "did not register periodic reader"
 		global.Error(errDuplicateRegister, msg)
 	}
@@ -213,6 +318,13 @@ func (r *PeriodicReader) aggregation(kind InstrumentKind) Aggregation { // nolin
 func (r *PeriodicReader) collectAndExport(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
+	// Exporters converting the Temporality of streams need their
+	// InstrumentKind.
+	ctx = withStreamKinds(ctx)
+
+	if r.batchSize > 0 {
+		return r.collectAndExportBatches(ctx, r.sdkProducer.Load())
+	}
 
 	// TODO (#3047): Use a sync.Pool or persistent pointer instead of allocating rm every Collect.
 	rm := r.rmPool.Get().(*metricdata.ResourceMetrics)
@@ -224,6 +336,55 @@ func (r *PeriodicReader) collectAndExport(ctx context.Context) error {
 	return err
 }
 
+// collectAndExportBatches unwraps p as a produceHolder and exports its
+// produce results, followed by the metric data of the external Producers, in
+// batches of the export batch size of r.
+func (r *PeriodicReader) collectAndExportBatches(ctx context.Context, p interface{}) error {
+	if p == nil {
+		return ErrReaderNotRegistered
+	}
+
+	ph, ok := p.(produceHolder)
+	if !ok {
+		// The atomic.Value is entirely in the periodicReader's control so
+		// this should never happen. In the unforeseen case that this does
+		// happen, return an error instead of panicking so a users code does
+		// not halt in the processes.
+		err := fmt.Errorf("periodic reader: invalid producer: %T", p)
+		return err
+	}
+
+	b := &batcher{
+		rm:     &metricdata.ResourceMetrics{},
+		size:   r.batchSize,
+		export: r.export,
+	}
+	var errs []error
+	if err := ph.produceBatches(ctx, b); err != nil {
+		errs = append(errs, err)
+		if ctx.Err() != nil {
+			return unifyErrors(errs)
+		}
+	}
+	for _, producer := range r.externalProducers.Load().([]Producer) {
+		externalMetrics, err := producer.Produce(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for _, sm := range externalMetrics {
+			for _, m := range sm.Metrics {
+				if err := b.add(ctx, sm.Scope, m); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	if err := b.flush(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return unifyErrors(errs)
+}
+
 // Collect gathers all metric data related to the Reader from
 // the SDK and other Producers and stores the result in rm. The metric
 // data is not exported to the configured exporter, it is left to the caller to
@@ -272,10 +433,24 @@ func (r *PeriodicReader) collect(ctx context.Context, p interface{}, rm *metricd
 	}
 
 	global.Debug("PeriodicReader collection", "Data", rm)
+	if len(errs) == 0 {
+		r.last.store(rm)
+	}
 
 	return unifyErrors(errs)
 }
 
+// LastCollection returns a copy of the metric data of the most recent
+// successful collection, and true. This includes the collections made to
+// export metric data and calls to Collect. If the PeriodicReader was not
+// created with the WithLastCollection option, or no collection has succeeded
+// yet, an empty ResourceMetrics and false are returned.
+//
+// This method is safe to call concurrently.
+func (r *PeriodicReader) LastCollection() (metricdata.ResourceMetrics, bool) {
+	return r.last.load()
+}
+
 // export exports metric data m using r's exporter.
 func (r *PeriodicReader) export(ctx context.Context, m *metricdata.ResourceMetrics) error {
 	return r.exporter.Export(ctx, m)
@@ -285,6 +460,12 @@ func (r *PeriodicReader) export(ctx context.Context, m *metricdata.ResourceMetri
 //
 // This method is safe to call concurrently.
 func (r *PeriodicReader) ForceFlush(ctx context.Context) error {
+	if r.flushTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.flushTimeout)
+		defer cancel()
+	}
+
 	// Prioritize the ctx timeout if it is set.
 	if _, ok := ctx.Deadline(); !ok {
 		var cancel context.CancelFunc
@@ -331,17 +512,24 @@ func (r *PeriodicReader) Shutdown(ctx context.Context) error {
 
 		// Any future call to Collect will now return ErrReaderShutdown.
 		ph := r.sdkProducer.Swap(produceHolder{
-			produce: shutdownProducer{}.produce,
+			produce:         shutdownProducer{}.produce,
+			produceMatching: shutdownProducer{}.produceMatching,
+			produceBatches:  shutdownProducer{}.produceBatches,
 		})
 
 		if ph != nil { // Reader was registered.
 			// Flush pending telemetry.
-			m := r.rmPool.Get().(*metricdata.ResourceMetrics)
-			err = r.collect(ctx, ph, m)
-			if err == nil {
-				err = r.export(ctx, m)
+			ctx := withStreamKinds(ctx)
+			if r.batchSize > 0 {
+				err = r.collectAndExportBatches(ctx, ph)
+			} else {
+				m := r.rmPool.Get().(*metricdata.ResourceMetrics)
+				err = r.collect(ctx, ph, m)
+				if err == nil {
+					err = r.export(ctx, m)
+				}
+				r.rmPool.Put(m)
 			}
-			r.rmPool.Put(m)
 		}
 
 		sErr := r.exporter.Shutdown(ctx)
@@ -16,6 +16,7 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"math"
 	"testing"
 	"time"
 
@@ -42,6 +43,30 @@ func TestWithTimeout(t *testing.T) {
 	assert.Equal(t, defaultTimeout, test(time.Duration(-1)), "invalid timeout should use default")
 }
 
+func TestWithExportBatchSize(t *testing.T) {
+	test := func(n int) int {
+		opts := []PeriodicReaderOption{WithExportBatchSize(n)}
+		return newPeriodicReaderConfig(opts).batchSize
+	}
+
+	assert.Equal(t, 10, test(10))
+	assert.Equal(t, 0, newPeriodicReaderConfig(nil).batchSize)
+	assert.Equal(t, 0, test(0), "invalid batch size should not be used")
+	assert.Equal(t, 0, test(-1), "invalid batch size should not be used")
+}
+
+func TestWithFlushTimeout(t *testing.T) {
+	test := func(d time.Duration) time.Duration {
+		opts := []PeriodicReaderOption{WithFlushTimeout(d)}
+		return newPeriodicReaderConfig(opts).flushTimeout
+	}
+
+	assert.Equal(t, testDur, test(testDur))
+	assert.Equal(t, time.Duration(0), newPeriodicReaderConfig(nil).flushTimeout)
+	assert.Equal(t, time.Duration(0), test(time.Duration(0)), "invalid flush timeout should not be used")
+	assert.Equal(t, time.Duration(0), test(time.Duration(-1)), "invalid flush timeout should not be used")
+}
+
 func TestTimeoutEnvVar(t *testing.T) {
 	testCases := []struct {
 		v    string
@@ -102,6 +127,45 @@ func TestWithInterval(t *testing.T) {
 	assert.Equal(t, defaultInterval, test(time.Duration(-1)), "invalid interval should use default")
 }
 
+func TestWithIntervalJitter(t *testing.T) {
+	test := func(f float64) float64 {
+		opts := []PeriodicReaderOption{WithIntervalJitter(f)}
+		return newPeriodicReaderConfig(opts).jitter
+	}
+
+	assert.Equal(t, 0.1, test(0.1))
+	assert.Equal(t, 0.0, newPeriodicReaderConfig(nil).jitter)
+	assert.Equal(t, 0.0, test(0), "invalid jitter should not be used")
+	assert.Equal(t, 0.0, test(-0.1), "invalid jitter should not be used")
+	assert.Equal(t, 0.0, test(1), "invalid jitter should not be used")
+	assert.Equal(t, 0.0, test(math.NaN()), "invalid jitter should not be used")
+}
+
+func TestPeriodicReaderJittered(t *testing.T) {
+	orig := randFloat64
+	t.Cleanup(func() { randFloat64 = orig })
+
+	var rnd float64
+	randFloat64 = func() float64 { return rnd }
+
+	r := &PeriodicReader{jitter: 0.5}
+	for _, tc := range []struct {
+		rnd  float64
+		want time.Duration
+	}{
+		{0, 5 * time.Second},
+		{0.5, 10 * time.Second},
+		{0.75, 12500 * time.Millisecond},
+	} {
+		rnd = tc.rnd
+		assert.Equal(t, tc.want, r.jittered(10*time.Second))
+	}
+
+	rnd = 0
+	r = &PeriodicReader{}
+	assert.Equal(t, 10*time.Second, r.jittered(10*time.Second), "no jitter")
+}
+
 func TestIntervalEnvVar(t *testing.T) {
 	testCases := []struct {
 		v    string
@@ -511,3 +575,205 @@ func TestPeriodicReaderCollect(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriodicReaderLastCollection(t *testing.T) {
+	trigger := triggerTicker(t)
+
+	exp := &fnExporter{}
+	r := NewPeriodicReader(exp, WithLastCollection())
+	r.register(testSDKProducer{})
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+
+	_, ok := r.LastCollection()
+	assert.False(t, ok, "last collection before any collection")
+
+	trigger <- time.Now()
+	// Flush to ensure the triggered collection has completed.
+	require.NoError(t, r.ForceFlush(context.Background()))
+
+	got, ok := r.LastCollection()
+	require.True(t, ok)
+	assert.Equal(t, testResourceMetricsA, got)
+}
+
+func TestPeriodicReaderFlushTimeout(t *testing.T) {
+	exp := &fnExporter{
+		flushFunc: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	r := NewPeriodicReader(exp, WithFlushTimeout(time.Millisecond))
+	r.register(testSDKProducer{})
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+
+	// The flush timeout needs to bound ForceFlush even if the passed context
+	// has a later deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	assert.ErrorIs(t, r.ForceFlush(ctx), context.DeadlineExceeded)
+}
+
+func TestPeriodicReaderExportBatches(t *testing.T) {
+	var (
+		batches []int
+		names   []string
+	)
+	exp := &fnExporter{
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			var n int
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					names = append(names, m.Name)
+					n++
+				}
+			}
+			batches = append(batches, n)
+			return nil
+		},
+	}
+	r := NewPeriodicReader(exp,
+		WithExportBatchSize(2),
+		WithProducer(testExternalProducer{}),
+	)
+	mp := NewMeterProvider(WithReader(r))
+
+	ctx := context.Background()
+	for _, scope := range []string{"a", "b"} {
+		meter := mp.Meter(scope)
+		for _, name := range []string{"1", "2", "3"} {
+			c, err := meter.Int64Counter(scope + name)
+			require.NoError(t, err)
+			c.Add(ctx, 1)
+		}
+	}
+
+	require.NoError(t, mp.Shutdown(ctx))
+	// Six SDK metrics and the one of testExternalProducer.
+	assert.Equal(t, []int{2, 2, 2, 1}, batches)
+	assert.ElementsMatch(t, []string{"a1", "a2", "a3", "b1", "b2", "b3", "fake scope data"}, names)
+}
+
+func TestPeriodicReaderExportBatchesCallbackError(t *testing.T) {
+	var names []string
+	exp := &fnExporter{
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					names = append(names, m.Name)
+				}
+			}
+			return nil
+		},
+	}
+	r := NewPeriodicReader(exp, WithExportBatchSize(1))
+	mp := NewMeterProvider(WithReader(r))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	meter := mp.Meter("test")
+	c, err := meter.Int64Counter("counter")
+	require.NoError(t, err)
+	c.Add(context.Background(), 1)
+	_, err = meter.Int64ObservableGauge("gauge", metric.WithInt64Callback(
+		func(context.Context, metric.Int64Observer) error { return assert.AnError },
+	))
+	require.NoError(t, err)
+	_, err = meter.Int64ObservableCounter("observable", metric.WithInt64Callback(
+		func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(1)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, r.ForceFlush(context.Background()), assert.AnError.Error())
+	assert.ElementsMatch(t, []string{"counter", "observable"}, names, "metric data not exported after callback error")
+}
+
+func TestPeriodicReaderExportBatchesExportError(t *testing.T) {
+	var calls int
+	exp := &fnExporter{
+		exportFunc: func(context.Context, *metricdata.ResourceMetrics) error {
+			calls++
+			return assert.AnError
+		},
+	}
+	r := NewPeriodicReader(exp,
+		WithExportBatchSize(1),
+		WithProducer(testExternalProducer{}),
+	)
+	mp := NewMeterProvider(WithReader(r))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	meter := mp.Meter("test")
+	for _, name := range []string{"a", "b"} {
+		c, err := meter.Int64Counter(name)
+		require.NoError(t, err)
+		c.Add(context.Background(), 1)
+	}
+
+	assert.ErrorContains(t, r.ForceFlush(context.Background()), assert.AnError.Error())
+	assert.Equal(t, 3, calls, "batches not exported after export error")
+}
+
+func TestPeriodicReaderExportBatchesConcurrentCollect(t *testing.T) {
+	var (
+		r         *PeriodicReader
+		exported  = map[string]int64{}
+		collected = make(chan metricdata.ResourceMetrics, 1)
+	)
+	exp := &fnExporter{
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			if len(exported) == 0 {
+				// Collect while the remaining batches are not yet computed.
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					var rm metricdata.ResourceMetrics
+					assert.NoError(t, r.Collect(context.Background(), &rm))
+					collected <- rm
+				}()
+				select {
+				case <-done:
+				case <-time.After(50 * time.Millisecond):
+				}
+			}
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					for _, dPt := range m.Data.(metricdata.Sum[int64]).DataPoints {
+						exported[m.Name] += dPt.Value
+					}
+				}
+			}
+			return nil
+		},
+	}
+	r = NewPeriodicReader(exp, WithExportBatchSize(1))
+	mp := NewMeterProvider(WithReader(r))
+	t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+	meter := mp.Meter("test")
+	for _, name := range []string{"a", "b"} {
+		_, err := meter.Int64ObservableCounter(name, metric.WithInt64Callback(
+			func(_ context.Context, o metric.Int64Observer) error {
+				o.Observe(1)
+				return nil
+			},
+		))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, r.ForceFlush(context.Background()))
+	assert.Equal(t, map[string]int64{"a": 1, "b": 1}, exported, "exported")
+
+	rm := <-collected
+	got := map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			for _, dPt := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				got[m.Name] += dPt.Value
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{"a": 1, "b": 1}, got, "collected")
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -37,6 +38,7 @@ var (
 	errCreatingAggregators     = errors.New("could not create all aggregators")
 	errIncompatibleAggregation = errors.New("incompatible aggregation")
 	errUnknownAggregation      = errors.New("unrecognized aggregation")
+	errNonIntegralScale        = errors.New("non-integral scale for int64 instrument")
 )
 
 // instrumentSync is a synchronization point between a pipeline and an
@@ -45,7 +47,29 @@ type instrumentSync struct {
 	name        string
 	description string
 	unit        string
+	kind        InstrumentKind
 	compAgg     aggregate.ComputeAggregation
+	// overflows counts the measurements aggregated into the overflow
+	// attribute set since the last collection.
+	overflows *atomic.Uint64
+}
+
+// warnOverflows logs a warning if measurements of the instrument were
+// aggregated into the overflow attribute set since the last call. The number
+// of these measurements is returned.
+func (i instrumentSync) warnOverflows() uint64 {
+	if i.overflows == nil {
+		return 0
+	}
+	n := i.overflows.Swap(0)
+	if n > 0 {
+		global.Warn(
+			"cardinality limit exceeded, measurements aggregated into overflow attribute set",
+			"instrument", i.name,
+			"overflows", n,
+		)
+	}
+	return n
 }
 
 func newPipeline(res *resource.Resource, reader Reader, views []View) *pipeline {
@@ -71,11 +95,79 @@ type pipeline struct {
 
 	reader Reader
 	views  []View
+	// cardinalityLimit is the default maximum number of attribute sets
+	// aggregated per instrument. A value less than or equal to zero means
+	// no limit.
+	cardinalityLimit int
+
+	// produceMu serializes collections. The callbacks and the computation
+	// of the aggregations of one collection cannot be interleaved with
+	// those of another, otherwise observations of the callbacks run for one
+	// collection would be computed by the other. It is acquired before the
+	// Mutex.
+	produceMu sync.Mutex
 
 	sync.Mutex
-	aggregations   map[instrumentation.Scope][]instrumentSync
+	aggregations map[instrumentation.Scope][]instrumentSync
+	// retired are the aggregations of instruments resolved with previous
+	// views. They no longer receive measurements and are removed once they
+	// are collected, so the measurements made before the views were updated
+	// are not lost.
+	retired        map[instrumentation.Scope][]instrumentSync
 	callbacks      []func(context.Context) error
 	multiCallbacks list.List
+	// overflows counts the measurements aggregated into overflow attribute
+	// sets by the instruments of previous collections.
+	overflows uint64
+}
+
+// setViews replaces the views of p with views and retires all aggregations
+// of instruments resolved with the previous views.
+func (p *pipeline) setViews(views []View) {
+	p.Lock()
+	defer p.Unlock()
+	p.views = views
+	for scope, instruments := range p.aggregations {
+		for _, inst := range instruments {
+			p.overflows += inst.warnOverflows()
+		}
+		if p.retired == nil {
+			p.retired = make(map[instrumentation.Scope][]instrumentSync)
+		}
+		p.retired[scope] = append(p.retired[scope], instruments...)
+	}
+	p.aggregations = nil
+}
+
+// collected returns the aggregations of p to collect, including the retired
+// aggregations match returns true for, or all if match is nil. The returned
+// retired aggregations are removed from p. p needs to be locked by the
+// caller.
+func (p *pipeline) collected(match func(instrumentation.Scope, string) bool) map[instrumentation.Scope][]instrumentSync {
+	if len(p.retired) == 0 {
+		return p.aggregations
+	}
+
+	aggs := make(map[instrumentation.Scope][]instrumentSync, len(p.aggregations)+len(p.retired))
+	for scope, instruments := range p.retired {
+		var kept []instrumentSync
+		for _, inst := range instruments {
+			if match != nil && !match(scope, inst.name) {
+				kept = append(kept, inst)
+				continue
+			}
+			aggs[scope] = append(aggs[scope], inst)
+		}
+		if len(kept) == 0 {
+			delete(p.retired, scope)
+		} else {
+			p.retired[scope] = kept
+		}
+	}
+	for scope, instruments := range p.aggregations {
+		aggs[scope] = append(aggs[scope], instruments...)
+	}
+	return aggs
 }
 
 // addSync adds the instrumentSync to pipeline p with scope. This method is not
@@ -120,49 +212,69 @@ func (p *pipeline) addMultiCallback(c multiCallback) (unregister func()) {
 //
 // This method is safe to call concurrently.
 func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return p.produceMatching(ctx, rm, nil)
+}
+
+// cardinalityOverflows returns the number of measurements aggregated into
+// overflow attribute sets by the instruments of p.
+//
+// This method is safe to call concurrently.
+func (p *pipeline) cardinalityOverflows() uint64 {
 	p.Lock()
 	defer p.Unlock()
 
-	var errs multierror
-	for _, c := range p.callbacks {
-		// TODO make the callbacks parallel. ( #3034 )
-		if err := c(ctx); err != nil {
-			errs.append(err)
-		}
-		if err := ctx.Err(); err != nil {
-			rm.Resource = nil
-			rm.ScopeMetrics = rm.ScopeMetrics[:0]
-			return err
+	n := p.overflows
+	for _, instruments := range p.aggregations {
+		for _, inst := range instruments {
+			if inst.overflows != nil {
+				n += inst.overflows.Load()
+			}
 		}
 	}
-	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
-		// TODO make the callbacks parallel. ( #3034 )
-		f := e.Value.(multiCallback)
-		if err := f(ctx); err != nil {
-			errs.append(err)
-		}
-		if err := ctx.Err(); err != nil {
-			// This means the context expired before we finished running callbacks.
-			rm.Resource = nil
-			rm.ScopeMetrics = rm.ScopeMetrics[:0]
-			return err
-		}
+	return n
+}
+
+// produceMatching returns aggregated metrics from a single collection of the
+// instruments match returns true for. If match is nil, all instruments are
+// collected. All callbacks are run regardless of match.
+//
+// This method is safe to call concurrently.
+func (p *pipeline) produceMatching(ctx context.Context, rm *metricdata.ResourceMetrics, match func(instrumentation.Scope, string) bool) error {
+	p.produceMu.Lock()
+	defer p.produceMu.Unlock()
+	p.Lock()
+	defer p.Unlock()
+
+	var errs multierror
+	if err := p.runCallbacks(ctx, &errs); err != nil {
+		rm.Resource = nil
+		rm.ScopeMetrics = rm.ScopeMetrics[:0]
+		return err
 	}
 
+	aggs := p.collected(match)
 	rm.Resource = p.resource
-	rm.ScopeMetrics = internal.ReuseSlice(rm.ScopeMetrics, len(p.aggregations))
+	rm.ScopeMetrics = internal.ReuseSlice(rm.ScopeMetrics, len(aggs))
+	kinds := streamKinds(ctx)
 
 	i := 0
-	for scope, instruments := range p.aggregations {
+	for scope, instruments := range aggs {
 		rm.ScopeMetrics[i].Metrics = internal.ReuseSlice(rm.ScopeMetrics[i].Metrics, len(instruments))
 		j := 0
 		for _, inst := range instruments {
+			if match != nil && !match(scope, inst.name) {
+				continue
+			}
+			p.overflows += inst.warnOverflows()
 			data := rm.ScopeMetrics[i].Metrics[j].Data
 			if n := inst.compAgg(&data); n > 0 {
 				rm.ScopeMetrics[i].Metrics[j].Name = inst.name
 				rm.ScopeMetrics[i].Metrics[j].Description = inst.description
 				rm.ScopeMetrics[i].Metrics[j].Unit = inst.unit
 				rm.ScopeMetrics[i].Metrics[j].Data = data
+				if kinds != nil {
+					kinds[streamID{scope: scope, name: inst.name}] = inst.kind
+				}
 				j++
 			}
 		}
@@ -178,6 +290,104 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	return errs.errorOrNil()
 }
 
+// produceBatches adds the aggregated metrics from a single collection to b
+// one at a time, so b can export them in batches. The aggregation of each
+// instrument is only computed once it is added to b.
+//
+// Like produce, the metrics are added to b even if a callback fails, and all
+// errors from the callbacks and from exporting batches are returned together
+// once all metrics are added. Only if the context expires are no further
+// metrics added to b.
+//
+// Other collections of p wait until all batches are added to b, so the
+// observations of the callbacks run for this collection are only computed
+// by it. Only the callbacks are run while p is locked, the aggregations are
+// computed and exported after p is unlocked so exporting does not block the
+// registration of instruments and callbacks. Therefore, the batches are not
+// a consistent snapshot: measurements made while a collection is exported
+// are included in the batches of the aggregations not yet computed, and an
+// instrument created during the export is not part of the collection.
+//
+// This method is safe to call concurrently.
+func (p *pipeline) produceBatches(ctx context.Context, b *batcher) error {
+	type scopeSync struct {
+		scope instrumentation.Scope
+		inst  instrumentSync
+	}
+
+	p.produceMu.Lock()
+	defer p.produceMu.Unlock()
+
+	p.Lock()
+	var errs multierror
+	if err := p.runCallbacks(ctx, &errs); err != nil {
+		p.Unlock()
+		return err
+	}
+	var syncs []scopeSync
+	for scope, instruments := range p.collected(nil) {
+		for _, inst := range instruments {
+			p.overflows += inst.warnOverflows()
+			syncs = append(syncs, scopeSync{scope: scope, inst: inst})
+		}
+	}
+	p.Unlock()
+
+	// Do not hold the lock while exporting, the aggregate functions are
+	// safe to call concurrently.
+	b.rm.Resource = p.resource
+	kinds := streamKinds(ctx)
+	for _, s := range syncs {
+		var data metricdata.Aggregation
+		if n := s.inst.compAgg(&data); n == 0 {
+			continue
+		}
+		if kinds != nil {
+			kinds[streamID{scope: s.scope, name: s.inst.name}] = s.inst.kind
+		}
+		err := b.add(ctx, s.scope, metricdata.Metrics{
+			Name:        s.inst.name,
+			Description: s.inst.description,
+			Unit:        s.inst.unit,
+			Data:        data,
+		})
+		if err != nil {
+			errs.append(err)
+			if ctx.Err() != nil {
+				break
+			}
+		}
+	}
+	return errs.errorOrNil()
+}
+
+// runCallbacks runs all registered callbacks of p, appending their errors to
+// errs. If the context expires before all callbacks are run, its error is
+// returned. p needs to be locked by the caller.
+func (p *pipeline) runCallbacks(ctx context.Context, errs *multierror) error {
+	for _, c := range p.callbacks {
+		// TODO make the callbacks parallel. ( #3034 )
+		if err := c(ctx); err != nil {
+			errs.append(err)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
+		// TODO make the callbacks parallel. ( #3034 )
+		f := e.Value.(multiCallback)
+		if err := f(ctx); err != nil {
+			errs.append(err)
+		}
+		if err := ctx.Err(); err != nil {
+			// This means the context expired before we finished running callbacks.
+			return err
+		}
+	}
+	return nil
+}
+
 // inserter facilitates inserting of new instruments from a single scope into a
 // pipeline.
 type inserter[N int64 | float64] struct {
@@ -338,6 +548,13 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 		)
 	}
 
+	if err := isScaleCompatible[N](stream.Scale); err != nil {
+		return nil, 0, fmt.Errorf(
+			"creating aggregator with instrumentKind: %d, scale %v: %w",
+			kind, stream.Scale, err,
+		)
+	}
+
 	id := i.instID(kind, stream)
 	// If there is a conflict, the specification says the view should
 	// still be applied and a warning should be logged.
@@ -349,9 +566,20 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 	normID := id.normalize()
 	cv := i.aggregators.Lookup(normID, func() aggVal[N] {
 		b := aggregate.Builder[N]{
-			Temporality: i.pipeline.reader.temporality(kind),
+			Temporality:      i.pipeline.reader.temporality(kind),
+			AggregationLimit: i.pipeline.cardinalityLimit,
+			Overflows:        new(atomic.Uint64),
+		}
+		if stream.AggregationLimit > 0 {
+			b.AggregationLimit = stream.AggregationLimit
 		}
 		b.Filter = stream.AttributeFilter
+		b.MeasurementFilter = stream.MeasurementFilter
+		b.Scale = stream.Scale
+		switch stream.Temporality {
+		case metricdata.DeltaTemporality, metricdata.CumulativeTemporality:
+			b.Temporality = stream.Temporality
+		}
 		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
 		if err != nil {
 			return aggVal[N]{0, nil, err}
@@ -365,7 +593,9 @@ func (i *inserter[N]) cachedAggregator(scope instrumentation.Scope, kind Instrum
 			name:        stream.Name,
 			description: stream.Description,
 			unit:        stream.Unit,
+			kind:        kind,
 			compAgg:     out,
+			overflows:   b.Overflows,
 		})
 		id := atomic.AddUint64(&aggIDCount, 1)
 		return aggVal[N]{id, in, err}
@@ -393,12 +623,15 @@ func (i *inserter[N]) logConflict(id instID) {
 		"units", fmt.Sprintf("%s, %s", existing.Unit, id.Unit),
 		"numbers", fmt.Sprintf("%s, %s", existing.Number, id.Number),
 	}
+	if id.Scale != existing.Scale {
+		args = append(args, "scales", fmt.Sprintf("%v, %v", existing.Scale, id.Scale))
+	}
 
 	// The specification recommends logging a suggested view to resolve
 	// conflicts if possible.
 	//
 	// https://github.com/open-telemetry/opentelemetry-specification/blob/v1.21.0/specification/metrics/sdk.md#duplicate-instrument-registration
-	if id.Unit != existing.Unit || id.Number != existing.Number {
+	if id.Unit != existing.Unit || id.Number != existing.Number || id.Scale != existing.Scale {
 		// There is no view resolution for these, don't make a suggestion.
 		global.Warn(msg, args...)
 		return
@@ -428,6 +661,7 @@ func (i *inserter[N]) instID(kind InstrumentKind, stream Stream) instID {
 		Unit:        stream.Unit,
 		Kind:        kind,
 		Number:      fmt.Sprintf("%T", zero),
+		Scale:       stream.Scale,
 	}
 }
 
@@ -465,6 +699,15 @@ func (i *inserter[N]) aggregateFunc(b aggregate.Builder[N], agg Aggregation, kin
 			noSum = true
 		}
 		meas, comp = b.ExplicitBucketHistogram(a.Boundaries, a.NoMinMax, noSum)
+	case AggregationSummary:
+		var noSum bool
+		switch kind {
+		case InstrumentKindUpDownCounter, InstrumentKindObservableUpDownCounter, InstrumentKindObservableGauge:
+			// The sum should not be collected for any instrument that can make
+			// negative measurements, the same as for histogram aggregations.
+			noSum = true
+		}
+		meas, comp = b.Summary(noSum)
 	case AggregationBase2ExponentialHistogram:
 		var noSum bool
 		switch kind {
@@ -498,7 +741,7 @@ func isAggregatorCompatible(kind InstrumentKind, agg Aggregation) error {
 	switch agg.(type) {
 	case AggregationDefault:
 		return nil
-	case AggregationExplicitBucketHistogram, AggregationBase2ExponentialHistogram:
+	case AggregationExplicitBucketHistogram, AggregationBase2ExponentialHistogram, AggregationSummary:
 		switch kind {
 		case InstrumentKindCounter,
 			InstrumentKindUpDownCounter,
@@ -534,20 +777,47 @@ func isAggregatorCompatible(kind InstrumentKind, agg Aggregation) error {
 	}
 }
 
+// isScaleCompatible checks if scale can be applied to measurements of number
+// type N. Scaling int64 measurements by a non-integral factor would truncate
+// the scaled values, e.g. 999 milliseconds scaled to seconds would be
+// recorded as 0, therefore only integral scales are compatible with int64.
+func isScaleCompatible[N int64 | float64](scale float64) error {
+	var zero N
+	if _, isInt := any(zero).(int64); isInt && scale > 0 && scale != math.Trunc(scale) {
+		return errNonIntegralScale
+	}
+	return nil
+}
+
 // pipelines is the group of pipelines connecting Readers with instrument
 // measurement.
 type pipelines []*pipeline
 
-func newPipelines(res *resource.Resource, readers []Reader, views []View) pipelines {
+func newPipelines(res *resource.Resource, readers []Reader, views []View, cardinalityLimit int) pipelines {
 	pipes := make([]*pipeline, 0, len(readers))
 	for _, r := range readers {
 		p := newPipeline(res, r, views)
+		p.cardinalityLimit = cardinalityLimit
 		r.register(p)
 		pipes = append(pipes, p)
 	}
 	return pipes
 }
 
+func (p pipelines) setViews(views []View) {
+	for _, pipe := range p {
+		pipe.setViews(views)
+	}
+}
+
+func (p pipelines) cardinalityOverflows() uint64 {
+	var n uint64
+	for _, pipe := range p {
+		n += pipe.cardinalityOverflows()
+	}
+	return n
+}
+
 func (p pipelines) registerCallback(cback func(context.Context) error) {
 	for _, pipe := range p {
 		pipe.addCallback(cback)
@@ -385,7 +385,7 @@ func TestInvalidInstrumentShouldPanic(t *testing.T) {
 
 func TestPipelinesAggregatorForEachReader(t *testing.T) {
 	r0, r1 := NewManualReader(), NewManualReader()
-	pipes := newPipelines(resource.Empty(), []Reader{r0, r1}, nil)
+	pipes := newPipelines(resource.Empty(), []Reader{r0, r1}, nil, 0)
 	require.Len(t, pipes, 2, "created pipelines")
 
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
@@ -457,7 +457,7 @@ func TestPipelineRegistryCreateAggregators(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			p := newPipelines(resource.Empty(), tt.readers, tt.views)
+			p := newPipelines(resource.Empty(), tt.readers, tt.views, 0)
 			testPipelineRegistryResolveIntAggregators(t, p, tt.wantCount)
 			testPipelineRegistryResolveFloatAggregators(t, p, tt.wantCount)
 		})
@@ -489,7 +489,7 @@ func TestPipelineRegistryResource(t *testing.T) {
 	readers := []Reader{NewManualReader()}
 	views := []View{defaultView, v}
 	res := resource.NewSchemaless(attribute.String("key", "val"))
-	pipes := newPipelines(res, readers, views)
+	pipes := newPipelines(res, readers, views, 0)
 	for _, p := range pipes {
 		assert.True(t, res.Equal(p.resource), "resource not set")
 	}
@@ -500,7 +500,7 @@ func TestPipelineRegistryCreateAggregatorsIncompatibleInstrument(t *testing.T) {
 
 	readers := []Reader{testRdrHistogram}
 	views := []View{defaultView}
-	p := newPipelines(resource.Empty(), readers, views)
+	p := newPipelines(resource.Empty(), readers, views, 0)
 	inst := Instrument{Name: "foo", Kind: InstrumentKindObservableGauge}
 
 	var vc cache[string, instID]
@@ -552,7 +552,7 @@ func TestResolveAggregatorsDuplicateErrors(t *testing.T) {
 	fooInst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	barInst := Instrument{Name: "bar", Kind: InstrumentKindCounter}
 
-	p := newPipelines(resource.Empty(), readers, views)
+	p := newPipelines(resource.Empty(), readers, views, 0)
 
 	var vc cache[string, instID]
 	ri := newResolver[int64](p, &vc)
@@ -626,6 +626,11 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			kind: InstrumentKindCounter,
 			agg:  AggregationBase2ExponentialHistogram{},
 		},
+		{
+			name: "SyncCounter and Summary",
+			kind: InstrumentKindCounter,
+			agg:  AggregationSummary{},
+		},
 		{
 			name: "SyncUpDownCounter and Drop",
 			kind: InstrumentKindUpDownCounter,
@@ -780,6 +785,12 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  AggregationBase2ExponentialHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "unknown kind with Summary should error",
+			kind: undefinedInstrument,
+			agg:  AggregationSummary{},
+			want: errIncompatibleAggregation,
+		},
 	}
 
 	for _, tt := range testCases {
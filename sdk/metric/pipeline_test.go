@@ -55,7 +55,7 @@ func TestNewPipeline(t *testing.T) {
 	assert.Equal(t, resource.Empty(), output.Resource)
 	assert.Len(t, output.ScopeMetrics, 0)
 
-	iSync := instrumentSync{"name", "desc", "1", testSumAggregateOutput}
+	iSync := instrumentSync{"name", "desc", "1", InstrumentKindCounter, testSumAggregateOutput, nil}
 	assert.NotPanics(t, func() {
 		pipe.addSync(instrumentation.Scope{}, iSync)
 	})
@@ -99,7 +99,7 @@ func TestPipelineConcurrentSafe(t *testing.T) {
 		go func(n int) {
 			defer wg.Done()
 			name := fmt.Sprintf("name %d", n)
-			sync := instrumentSync{name, "desc", "1", testSumAggregateOutput}
+			sync := instrumentSync{name, "desc", "1", InstrumentKindCounter, testSumAggregateOutput, nil}
 			pipe.addSync(instrumentation.Scope{}, sync)
 		}(i)
 
@@ -357,6 +357,23 @@ func TestLogConflictSuggestView(t *testing.T) {
 		// Reset.
 		msg = ""
 	})
+
+	t.Run("Scale", func(t *testing.T) {
+		inst := instID{
+			Name:        orig.Name,
+			Description: orig.Description,
+			Kind:        orig.Kind,
+			Unit:        orig.Unit,
+			Number:      orig.Number,
+			Scale:       1000,
+		}
+		i.logConflict(inst)
+		assert.Contains(t, msg, "scales", "scales not logged: %v", inst)
+		assert.NotContains(t, msg, "NewView", "suggestion logged: %v", inst)
+
+		// Reset.
+		msg = ""
+	})
 }
 
 func TestInserterCachedAggregatorNameConflict(t *testing.T) {
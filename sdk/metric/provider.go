@@ -53,7 +53,7 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 	flush, sdown := conf.readerSignals()
 
 	mp := &MeterProvider{
-		pipes:      newPipelines(conf.res, conf.readers, conf.views),
+		pipes:      newPipelines(conf.res, conf.readers, conf.views, conf.cardinalityLimit),
 		forceFlush: flush,
 		shutdown:   sdown,
 	}
@@ -103,6 +103,61 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 	})
 }
 
+// UpdateViews replaces the views of the MeterProvider with views. All
+// instruments the MeterProvider's Meters have created are resolved again
+// with views, and instruments created afterwards use views. Calling
+// UpdateViews with no views restores the default view.
+//
+// The aggregations of all instruments are restarted with the new views, and
+// cumulative aggregations restart from zero with a new start time. The
+// aggregations of the previous views are collected a last time by the next
+// collection of each Reader, so the measurements made before views are
+// updated are not lost. The callbacks of observable instruments are only run
+// while the instruments are aggregated, including observable instruments the
+// previous views dropped.
+//
+// An error is returned if any instrument resolves to an incompatible
+// aggregation with views. Those aggregations are not used.
+//
+// This method is safe to call concurrently.
+func (mp *MeterProvider) UpdateViews(views ...View) error {
+	// Hold the lock of the meters cache so no Meter is created, and the lock
+	// of all Meters so no instrument is created, while views are updated.
+	mp.meters.Lock()
+	defer mp.meters.Unlock()
+
+	for _, m := range mp.meters.data {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+	}
+
+	mp.pipes.setViews(views)
+
+	var errs []error
+	for _, m := range mp.meters.data {
+		if err := m.resolveAgain(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	global.Info("MeterProvider views updated", "Views", len(views))
+	return unifyErrors(errs)
+}
+
+// CardinalityOverflows returns the number of measurements aggregated into
+// the overflow attribute set, otel.metric.overflow=true, of a stream because
+// the cardinality limit of the stream was reached. The count includes all
+// streams since the MeterProvider was created. If the MeterProvider has
+// multiple Readers, a measurement is counted for each Reader whose stream
+// overflowed.
+//
+// A growing count means instruments record more distinct attribute sets
+// than their cardinality limit, see WithCardinalityLimit.
+//
+// This method is safe to call concurrently.
+func (mp *MeterProvider) CardinalityOverflows() uint64 {
+	return mp.pipes.cardinalityOverflows()
+}
+
 // ForceFlush flushes all pending telemetry.
 //
 // This method honors the deadline or cancellation of ctx. An appropriate
@@ -111,7 +166,9 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 // situations.
 //
 // ForceFlush calls ForceFlush(context.Context) error
-// on all Readers that implements this method.
+// on all Readers that implements this method. The Readers are flushed
+// concurrently, use the WithFlushTimeout option of a PeriodicReader to
+// bound the time it can take.
 //
 // This method is safe to call concurrently.
 func (mp *MeterProvider) ForceFlush(ctx context.Context) error {
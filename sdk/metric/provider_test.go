@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	api "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -183,3 +184,268 @@ func TestMeterProviderMixingOnRegisterErrors(t *testing.T) {
 		"Metrics produced for instrument collected by different MeterProvider",
 	)
 }
+
+func TestMeterProviderCardinalityLimit(t *testing.T) {
+	var buf strings.Builder
+	warnLevel := 1
+	l := funcr.New(func(prefix, args string) {
+		_, _ = buf.WriteString(fmt.Sprint(prefix, args))
+	}, funcr.Options{Verbosity: warnLevel})
+	otel.SetLogger(l)
+
+	rdr := NewManualReader()
+	view := NewView(Instrument{Name: "limited"}, Stream{AggregationLimit: 3})
+	mp := NewMeterProvider(WithReader(rdr), WithView(view), WithCardinalityLimit(2))
+	m := mp.Meter("TestMeterProviderCardinalityLimit")
+
+	ctx := context.Background()
+	ctr, err := m.Int64Counter("counter")
+	require.NoError(t, err)
+	limited, err := m.Int64Counter("limited")
+	require.NoError(t, err)
+	for _, user := range []string{"alice", "bob", "carol"} {
+		opt := api.WithAttributes(attribute.String("user", user))
+		ctr.Add(ctx, 1, opt)
+		limited.Add(ctx, 1, opt)
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+
+	overflow := attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		sum, ok := m.Data.(metricdata.Sum[int64])
+		require.True(t, ok, "unexpected aggregation %T", m.Data)
+		dps := map[attribute.Distinct]int64{}
+		for _, dp := range sum.DataPoints {
+			dps[dp.Attributes.Equivalent()] = dp.Value
+		}
+
+		switch m.Name {
+		case "counter":
+			assert.Len(t, dps, 2)
+			assert.Equal(t, int64(2), dps[overflow.Equivalent()])
+		case "limited":
+			assert.Len(t, dps, 3)
+			assert.Equal(t, int64(1), dps[overflow.Equivalent()])
+		}
+	}
+
+	assert.Contains(t, buf.String(), `"instrument"="counter" "overflows"=2`)
+	assert.Contains(t, buf.String(), `"instrument"="limited" "overflows"=1`)
+}
+
+func TestMeterProviderCardinalityOverflows(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithCardinalityLimit(2))
+	ctr, err := mp.Meter("TestMeterProviderCardinalityOverflows").Int64Counter("counter")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), mp.CardinalityOverflows())
+
+	ctx := context.Background()
+	add := func(users ...string) {
+		for _, user := range users {
+			ctr.Add(ctx, 1, api.WithAttributes(attribute.String("user", user)))
+		}
+	}
+	add("alice", "bob", "carol")
+	assert.Equal(t, uint64(2), mp.CardinalityOverflows(), "before collection")
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	assert.Equal(t, uint64(2), mp.CardinalityOverflows(), "after collection")
+
+	add("dave")
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	assert.Equal(t, uint64(3), mp.CardinalityOverflows(), "second collection")
+
+	add("erin")
+	require.NoError(t, mp.UpdateViews())
+	assert.Equal(t, uint64(4), mp.CardinalityOverflows(), "after views updated")
+}
+
+func TestMeterProviderUpdateViews(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+	ctr, err := mp.Meter("TestMeterProviderUpdateViews").Int64Counter("requests")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	add := func() {
+		for _, user := range []string{"alice", "bob"} {
+			ctr.Add(ctx, 1, api.WithAttributes(
+				attribute.String("http.method", "GET"),
+				attribute.String("user", user),
+			))
+		}
+	}
+	collect := func() map[string]metricdata.Sum[int64] {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, rdr.Collect(ctx, &rm))
+		got := make(map[string]metricdata.Sum[int64])
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				got[m.Name] = m.Data.(metricdata.Sum[int64])
+			}
+		}
+		return got
+	}
+
+	add()
+	got := collect()
+	require.Len(t, got, 1)
+	assert.Len(t, got["requests"].DataPoints, 2)
+
+	view := NewView(Instrument{Name: "requests"}, Stream{
+		Name:            "http.requests",
+		AttributeFilter: NewAllowKeyPatternsFilter("http.*"),
+	})
+	require.NoError(t, mp.UpdateViews(view))
+	add()
+	got = collect()
+	// The aggregation of the previous views is collected a last time.
+	require.Len(t, got, 2)
+	assert.Len(t, got["requests"].DataPoints, 2)
+	dps := got["http.requests"].DataPoints
+	require.Len(t, dps, 1)
+	assert.Equal(t, attribute.NewSet(attribute.String("http.method", "GET")), dps[0].Attributes)
+	assert.Equal(t, int64(2), dps[0].Value, "aggregation not restarted")
+
+	add()
+	got = collect()
+	require.Len(t, got, 1)
+	assert.Contains(t, got, "http.requests")
+
+	drop := NewView(Instrument{Name: "requests"}, Stream{Aggregation: AggregationDrop{}})
+	require.NoError(t, mp.UpdateViews(drop))
+	add()
+	got = collect()
+	require.Len(t, got, 1)
+	assert.Contains(t, got, "http.requests", "aggregation of previous views not collected")
+	assert.Len(t, collect(), 0)
+
+	// Instruments created after an update use the updated views.
+	other, err := mp.Meter("TestMeterProviderUpdateViews").Int64Counter("requests")
+	require.NoError(t, err)
+	other.Add(ctx, 1)
+	assert.Len(t, collect(), 0)
+
+	// Removing all views restores the default view.
+	require.NoError(t, mp.UpdateViews())
+	add()
+	got = collect()
+	require.Len(t, got, 1)
+	assert.Len(t, got["requests"].DataPoints, 2)
+}
+
+func TestMeterProviderUpdateViewsKeepsPendingMeasurements(t *testing.T) {
+	rdr := NewManualReader(WithTemporalitySelector(func(InstrumentKind) metricdata.Temporality {
+		return metricdata.DeltaTemporality
+	}))
+	mp := NewMeterProvider(WithReader(rdr))
+	ctr, err := mp.Meter("TestMeterProviderUpdateViewsKeepsPendingMeasurements").Int64Counter("requests")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ctr.Add(ctx, 5)
+	require.NoError(t, mp.UpdateViews(NewView(
+		Instrument{Name: "requests"},
+		Stream{Name: "http.requests"},
+	)))
+	ctr.Add(ctx, 1)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	got := make(map[string]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			for _, dPt := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				got[m.Name] += dPt.Value
+			}
+		}
+	}
+	assert.Equal(t, map[string]int64{"requests": 5, "http.requests": 1}, got)
+
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	assert.Len(t, rm.ScopeMetrics, 0, "aggregation of previous views collected again")
+}
+
+func TestMeterProviderUpdateViewsRegistersCallbacks(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithView(NewView(Instrument{Name: "*"}, Stream{Aggregation: AggregationDrop{}})),
+	)
+	m := mp.Meter("TestMeterProviderUpdateViewsRegistersCallbacks")
+
+	var instCalls, regCalls int
+	_, err := m.Int64ObservableGauge("gauge", api.WithInt64Callback(
+		func(_ context.Context, o api.Int64Observer) error {
+			instCalls++
+			o.Observe(1)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+	ctr, err := m.Float64ObservableCounter("counter")
+	require.NoError(t, err)
+	_, err = m.RegisterCallback(func(_ context.Context, o api.Observer) error {
+		regCalls++
+		o.ObserveFloat64(ctr, 2)
+		return nil
+	}, ctr)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	assert.Len(t, rm.ScopeMetrics, 0)
+	assert.Equal(t, 0, instCalls, "instrument callback called for drop aggregation")
+	assert.Equal(t, 0, regCalls, "registered callback called for drop aggregation")
+
+	require.NoError(t, mp.UpdateViews())
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	assert.Equal(t, 1, instCalls, "instrument callback not registered")
+	assert.Equal(t, 1, regCalls, "registered callback not registered")
+	require.Len(t, rm.ScopeMetrics, 1)
+	got := make(map[string]metricdata.Aggregation)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		got[m.Name] = m.Data
+	}
+	require.Contains(t, got, "gauge")
+	require.Contains(t, got, "counter")
+	assert.Equal(t, int64(1), got["gauge"].(metricdata.Gauge[int64]).DataPoints[0].Value)
+	assert.Equal(t, float64(2), got["counter"].(metricdata.Sum[float64]).DataPoints[0].Value)
+}
+
+func TestMeterProviderUpdateViewsConcurrentSafe(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+	ctx := context.Background()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10; i++ {
+			m := mp.Meter(fmt.Sprintf("meter %d", i))
+			ctr, _ := m.Int64Counter("counter")
+			ctr.Add(ctx, 1)
+			_, _ = m.Float64ObservableGauge("gauge", api.WithFloat64Callback(
+				func(_ context.Context, o api.Float64Observer) error {
+					o.Observe(1)
+					return nil
+				},
+			))
+			var rm metricdata.ResourceMetrics
+			_ = rdr.Collect(ctx, &rm)
+		}
+	}()
+
+	view := NewView(Instrument{Name: "*"}, Stream{Description: "updated"})
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, mp.UpdateViews(view))
+	}
+	<-done
+}
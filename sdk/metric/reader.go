@@ -17,7 +17,10 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -103,6 +106,18 @@ type sdkProducer interface {
 	//
 	// This method is safe to call concurrently.
 	produce(context.Context, *metricdata.ResourceMetrics) error
+
+	// produceMatching returns aggregated metrics from a single collection of
+	// the instruments the passed function returns true for.
+	//
+	// This method is safe to call concurrently.
+	produceMatching(context.Context, *metricdata.ResourceMetrics, func(instrumentation.Scope, string) bool) error
+
+	// produceBatches adds aggregated metrics from a single collection to the
+	// batcher one at a time.
+	//
+	// This method is safe to call concurrently.
+	produceBatches(context.Context, *batcher) error
 }
 
 // Producer produces metrics for a Reader from an external source.
@@ -121,7 +136,9 @@ type Producer interface {
 // produceHolder is used as an atomic.Value to wrap the non-concrete producer
 // type.
 type produceHolder struct {
-	produce func(context.Context, *metricdata.ResourceMetrics) error
+	produce         func(context.Context, *metricdata.ResourceMetrics) error
+	produceMatching func(context.Context, *metricdata.ResourceMetrics, func(instrumentation.Scope, string) bool) error
+	produceBatches  func(context.Context, *batcher) error
 }
 
 // shutdownProducer produces an ErrReaderShutdown error always.
@@ -132,6 +149,56 @@ func (p shutdownProducer) produce(context.Context, *metricdata.ResourceMetrics)
 	return ErrReaderShutdown
 }
 
+// produceMatching returns an ErrReaderShutdown error.
+func (p shutdownProducer) produceMatching(context.Context, *metricdata.ResourceMetrics, func(instrumentation.Scope, string) bool) error {
+	return ErrReaderShutdown
+}
+
+// produceBatches returns an ErrReaderShutdown error.
+func (p shutdownProducer) produceBatches(context.Context, *batcher) error {
+	return ErrReaderShutdown
+}
+
+// batcher groups Metrics into batches of a maximum number of Metrics and
+// exports each batch once it is full.
+type batcher struct {
+	// rm holds the current batch. Its Resource is used for all batches.
+	rm     *metricdata.ResourceMetrics
+	size   int
+	n      int
+	export func(context.Context, *metricdata.ResourceMetrics) error
+}
+
+// add adds m of scope to the current batch, exporting the batch if it is
+// full.
+func (b *batcher) add(ctx context.Context, scope instrumentation.Scope, m metricdata.Metrics) error {
+	last := len(b.rm.ScopeMetrics) - 1
+	if last < 0 || b.rm.ScopeMetrics[last].Scope != scope {
+		b.rm.ScopeMetrics = append(b.rm.ScopeMetrics, metricdata.ScopeMetrics{Scope: scope})
+		last++
+	}
+	b.rm.ScopeMetrics[last].Metrics = append(b.rm.ScopeMetrics[last].Metrics, m)
+
+	b.n++
+	if b.n < b.size {
+		return nil
+	}
+	return b.flush(ctx)
+}
+
+// flush exports the current batch if it is not empty and starts a new one.
+func (b *batcher) flush(ctx context.Context) error {
+	if b.n == 0 {
+		return nil
+	}
+	err := b.export(ctx, b.rm)
+	// Do not reuse the exported Metrics, the exporter may still reference
+	// them if it failed.
+	b.rm.ScopeMetrics = nil
+	b.n = 0
+	return err
+}
+
 // TemporalitySelector selects the temporality to use based on the InstrumentKind.
 type TemporalitySelector func(InstrumentKind) metricdata.Temporality
 
@@ -170,6 +237,36 @@ func DefaultAggregationSelector(ik InstrumentKind) Aggregation {
 	panic("unknown instrument kind")
 }
 
+// HistogramBoundariesSelector returns an AggregationSelector that selects the
+// same aggregations as DefaultAggregationSelector, but uses boundaries as the
+// bucket boundaries of the ExplicitBucketHistogram of Histogram instruments.
+// Use it with WithAggregationSelector, or the aggregation selector option of
+// an exporter, to change the default buckets of all histograms without
+// defining a view for each of them.
+//
+// The boundaries need to be increasing. If they are not, an error is logged
+// and DefaultAggregationSelector is returned.
+//
+// The default boundaries cannot be configured from the environment, the
+// OpenTelemetry specification does not define an environment variable for
+// them. Applications that want to configure them without code changes need
+// to read the boundaries from their own configuration and pass them here.
+func HistogramBoundariesSelector(boundaries ...float64) AggregationSelector {
+	hist := AggregationExplicitBucketHistogram{Boundaries: boundaries}
+	if err := hist.err(); err != nil {
+		global.Error(err, "using default histogram boundaries", "boundaries", boundaries)
+		return DefaultAggregationSelector
+	}
+	// Copy to make the boundaries immutable after the selector is returned.
+	hist = hist.copy().(AggregationExplicitBucketHistogram)
+	return func(ik InstrumentKind) Aggregation {
+		if ik == InstrumentKindHistogram {
+			return hist.copy()
+		}
+		return DefaultAggregationSelector(ik)
+	}
+}
+
 // ReaderOption is an option which can be applied to manual or Periodic
 // readers.
 type ReaderOption interface {
@@ -198,3 +295,51 @@ func (o producerOption) applyPeriodic(c periodicReaderConfig) periodicReaderConf
 	c.producers = append(c.producers, o.p)
 	return c
 }
+
+// WithLastCollection configures a Reader to keep a copy of the metric data of
+// its most recent successful collection. The copy is returned by the
+// LastCollection method of the Reader without collecting again.
+//
+// Keeping the copy adds the cost of copying all metric data to every
+// collection.
+func WithLastCollection() ReaderOption {
+	return lastCollectionOption{}
+}
+
+type lastCollectionOption struct{}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o lastCollectionOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.keepLast = true
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o lastCollectionOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.keepLast = true
+	return c
+}
+
+// lastCollection holds a copy of the most recent collection of a Reader.
+type lastCollection struct {
+	enabled bool
+	rm      atomic.Pointer[metricdata.ResourceMetrics]
+}
+
+// store keeps a copy of rm if l is enabled.
+func (l *lastCollection) store(rm *metricdata.ResourceMetrics) {
+	if !l.enabled {
+		return
+	}
+	c := rm.Clone()
+	l.rm.Store(&c)
+}
+
+// load returns a copy of the kept collection, and if one was kept.
+func (l *lastCollection) load() (metricdata.ResourceMetrics, bool) {
+	rm := l.rm.Load()
+	if rm == nil {
+		return metricdata.ResourceMetrics{}, false
+	}
+	return rm.Clone(), true
+}
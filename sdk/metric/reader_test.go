@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -270,6 +271,26 @@ func (p testSDKProducer) produce(ctx context.Context, rm *metricdata.ResourceMet
 	return nil
 }
 
+func (p testSDKProducer) produceMatching(ctx context.Context, rm *metricdata.ResourceMetrics, _ func(instrumentation.Scope, string) bool) error {
+	return p.produce(ctx, rm)
+}
+
+func (p testSDKProducer) produceBatches(ctx context.Context, b *batcher) error {
+	var rm metricdata.ResourceMetrics
+	if err := p.produce(ctx, &rm); err != nil {
+		return err
+	}
+	b.rm.Resource = rm.Resource
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if err := b.add(ctx, sm.Scope, m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 type testExternalProducer struct {
 	produceFunc func(context.Context) ([]metricdata.ScopeMetrics, error)
 }
@@ -321,6 +342,38 @@ func TestDefaultAggregationSelector(t *testing.T) {
 	}
 }
 
+func TestHistogramBoundariesSelector(t *testing.T) {
+	bounds := []float64{0.1, 0.5, 1, 5}
+	selector := HistogramBoundariesSelector(bounds...)
+	bounds[0] = -1
+	assert.Equal(t, AggregationExplicitBucketHistogram{
+		Boundaries: []float64{0.1, 0.5, 1, 5},
+	}, selector(InstrumentKindHistogram), "boundaries not copied")
+
+	for _, ik := range []InstrumentKind{
+		InstrumentKindCounter,
+		InstrumentKindUpDownCounter,
+		InstrumentKindObservableCounter,
+		InstrumentKindObservableUpDownCounter,
+		InstrumentKindObservableGauge,
+	} {
+		assert.Equal(t, DefaultAggregationSelector(ik), selector(ik), ik)
+	}
+}
+
+func TestHistogramBoundariesSelectorInvalid(t *testing.T) {
+	tLog := testr.NewWithOptions(t, testr.Options{Verbosity: 6})
+	l := &logCounter{LogSink: tLog.GetSink()}
+	otel.SetLogger(logr.New(l))
+
+	selector := HistogramBoundariesSelector(5, 1)
+	for i := 0; i < 3; i++ {
+		got := selector(InstrumentKindHistogram)
+		assert.Equal(t, DefaultAggregationSelector(InstrumentKindHistogram), got)
+	}
+	assert.Equal(t, 1, l.ErrorN(), "invalid boundaries not logged once")
+}
+
 func TestDefaultTemporalitySelector(t *testing.T) {
 	var undefinedInstrument InstrumentKind
 	for _, ik := range []InstrumentKind{
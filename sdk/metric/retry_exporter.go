@@ -0,0 +1,333 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Default retry exporter backoff.
+const (
+	defaultRetryInitialInterval = 5 * time.Second
+	defaultRetryMaxInterval     = 30 * time.Second
+	defaultRetryMaxElapsedTime  = time.Minute
+
+	retryMultiplier          = 1.5
+	retryRandomizationFactor = 0.5
+)
+
+// errRetryBufferFull is handled when the oldest buffered metric data of a
+// retry exporter is dropped.
+var errRetryBufferFull = errors.New("retry exporter: buffer full, dropping oldest metric data")
+
+// retryConfig contains configuration options for a retry exporter.
+type retryConfig struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	maxElapsedTime  time.Duration
+	bufferSize      int
+	retryable       func(error) bool
+}
+
+// newRetryConfig returns a retryConfig configured with options.
+func newRetryConfig(options []RetryOption) retryConfig {
+	c := retryConfig{
+		initialInterval: defaultRetryInitialInterval,
+		maxInterval:     defaultRetryMaxInterval,
+		maxElapsedTime:  defaultRetryMaxElapsedTime,
+		retryable:       defaultRetryable,
+	}
+	for _, o := range options {
+		c = o.applyRetry(c)
+	}
+	return c
+}
+
+// defaultRetryable returns if err is not caused by a shutdown Exporter.
+func defaultRetryable(err error) bool {
+	return !errors.Is(err, ErrExporterShutdown)
+}
+
+// RetryOption applies a configuration option value to an Exporter returned by
+// NewRetryExporter.
+type RetryOption interface {
+	applyRetry(retryConfig) retryConfig
+}
+
+// retryOptionFunc applies a set of options to a retryConfig.
+type retryOptionFunc func(retryConfig) retryConfig
+
+// applyRetry returns a retryConfig with option(s) applied.
+func (o retryOptionFunc) applyRetry(conf retryConfig) retryConfig {
+	return o(conf)
+}
+
+// WithRetryInitialInterval configures the time to wait after the first failed
+// export before retrying. The time waited grows exponentially with each
+// further failed attempt.
+//
+// If this option is not used or d is less than or equal to zero, 5 seconds is
+// used as the default.
+func WithRetryInitialInterval(d time.Duration) RetryOption {
+	return retryOptionFunc(func(conf retryConfig) retryConfig {
+		if d <= 0 {
+			return conf
+		}
+		conf.initialInterval = d
+		return conf
+	})
+}
+
+// WithRetryMaxInterval configures the upper bound of the time to wait between
+// attempts to export.
+//
+// If this option is not used or d is less than or equal to zero, 30 seconds
+// is used as the default.
+func WithRetryMaxInterval(d time.Duration) RetryOption {
+	return retryOptionFunc(func(conf retryConfig) retryConfig {
+		if d <= 0 {
+			return conf
+		}
+		conf.maxInterval = d
+		return conf
+	})
+}
+
+// WithRetryMaxElapsedTime configures the maximum time spent trying to export
+// metric data, including all retries. Once elapsed, the export fails.
+//
+// If this option is not used or d is less than or equal to zero, 1 minute is
+// used as the default.
+func WithRetryMaxElapsedTime(d time.Duration) RetryOption {
+	return retryOptionFunc(func(conf retryConfig) retryConfig {
+		if d <= 0 {
+			return conf
+		}
+		conf.maxElapsedTime = d
+		return conf
+	})
+}
+
+// WithRetryBufferSize configures the number of failed exports whose metric
+// data is kept to be exported again. The kept metric data is exported again,
+// oldest first, before the metric data of the next export, or when the
+// Exporter is flushed or shut down. Once n exports are kept, the oldest one
+// is dropped.
+//
+// Keeping metric data is most useful with exporters using DeltaTemporality,
+// which would otherwise lose the measurements of a failed export.
+//
+// If this option is not used or n is less than or equal to zero, metric data
+// of failed exports is not kept.
+func WithRetryBufferSize(n int) RetryOption {
+	return retryOptionFunc(func(conf retryConfig) retryConfig {
+		if n <= 0 {
+			return conf
+		}
+		conf.bufferSize = n
+		return conf
+	})
+}
+
+// WithRetryable configures the function used to determine if an error
+// returned by an export can be retried.
+//
+// If this option is not used or f is nil, all errors except
+// ErrExporterShutdown are retried.
+func WithRetryable(f func(error) bool) RetryOption {
+	return retryOptionFunc(func(conf retryConfig) retryConfig {
+		if f == nil {
+			return conf
+		}
+		conf.retryable = f
+		return conf
+	})
+}
+
+// retryExporter is an Exporter that retries failed exports of another
+// Exporter.
+type retryExporter struct {
+	Exporter
+
+	conf retryConfig
+
+	mu     sync.Mutex
+	buffer []*metricdata.ResourceMetrics
+}
+
+// Compile time check retryExporter implements Exporter.
+var _ Exporter = (*retryExporter)(nil)
+
+// NewRetryExporter returns an Exporter that retries failed exports of
+// exporter using an exponential backoff with jitter. Retries stop once an
+// export succeeds, the error is not retryable, the maximum elapsed time is
+// reached, or the passed context is done.
+//
+// All other methods are delegated to exporter.
+func NewRetryExporter(exporter Exporter, options ...RetryOption) Exporter {
+	return &retryExporter{
+		Exporter: exporter,
+		conf:     newRetryConfig(options),
+	}
+}
+
+// Export exports the kept metric data of previously failed exports and then
+// rm, retrying the failed export of rm.
+func (e *retryExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	bufErr := e.exportBuffer(ctx)
+
+	err := e.export(ctx, rm)
+	if err != nil && e.conf.bufferSize > 0 && e.conf.retryable(err) {
+		c := rm.Clone()
+		e.keep(&c)
+	}
+	if err == nil {
+		return bufErr
+	}
+	return err
+}
+
+// export exports rm with e's exporter, retrying failed attempts.
+func (e *retryExporter) export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	start := time.Now()
+	interval := e.conf.initialInterval
+	for {
+		err := e.Exporter.Export(ctx, rm)
+		if err == nil || !e.conf.retryable(err) {
+			return err
+		}
+
+		delay := jitterRetryInterval(interval)
+		if time.Since(start)+delay > e.conf.maxElapsedTime {
+			return fmt.Errorf("max retry time elapsed: %w", err)
+		}
+		if ctxErr := retryWaitFunc(ctx, delay); ctxErr != nil {
+			return fmt.Errorf("%w: %s", ctxErr, err)
+		}
+
+		interval = time.Duration(float64(interval) * retryMultiplier)
+		if interval > e.conf.maxInterval {
+			interval = e.conf.maxInterval
+		}
+	}
+}
+
+// jitterRetryInterval returns interval randomly varied by the retry
+// randomization factor.
+func jitterRetryInterval(interval time.Duration) time.Duration {
+	delta := (2*randFloat64() - 1) * retryRandomizationFactor * float64(interval)
+	return interval + time.Duration(delta)
+}
+
+// keep adds rm to the buffer of e, dropping the oldest metric data if the
+// buffer is full.
+func (e *retryExporter) keep(rm *metricdata.ResourceMetrics) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.buffer) >= e.conf.bufferSize {
+		otel.Handle(errRetryBufferFull)
+		e.buffer = e.buffer[1:]
+	}
+	e.buffer = append(e.buffer, rm)
+}
+
+// exportBuffer exports the kept metric data of e, oldest first, once each.
+// Exporting stops at the first metric data that fails with a retryable
+// error, it and all following metric data are kept. Metric data failing with
+// an error that is not retryable is dropped. The errors of all failed exports
+// are returned.
+func (e *retryExporter) exportBuffer(ctx context.Context) error {
+	e.mu.Lock()
+	buffer := e.buffer
+	e.buffer = nil
+	e.mu.Unlock()
+
+	var errs []error
+	for i, rm := range buffer {
+		err := e.Exporter.Export(ctx, rm)
+		if err == nil {
+			continue
+		}
+		errs = append(errs, err)
+		if e.conf.retryable(err) {
+			e.mu.Lock()
+			// Keep the failed and all following metric data ahead of data
+			// kept meanwhile, to export it in order.
+			e.buffer = append(buffer[i:len(buffer):len(buffer)], e.buffer...)
+			e.mu.Unlock()
+			break
+		}
+	}
+	return unifyErrors(errs)
+}
+
+// ForceFlush exports the kept metric data of previously failed exports and
+// then flushes e's exporter.
+func (e *retryExporter) ForceFlush(ctx context.Context) error {
+	err := e.exportBuffer(ctx)
+	if fErr := e.Exporter.ForceFlush(ctx); fErr != nil {
+		return fErr
+	}
+	return err
+}
+
+// Shutdown exports the kept metric data of previously failed exports and
+// then shuts down e's exporter. Metric data still failing to export is
+// dropped.
+func (e *retryExporter) Shutdown(ctx context.Context) error {
+	err := e.exportBuffer(ctx)
+	e.mu.Lock()
+	e.buffer = nil
+	e.mu.Unlock()
+
+	if sErr := e.Exporter.Shutdown(ctx); sErr != nil {
+		return sErr
+	}
+	return err
+}
+
+// Allow override for testing.
+var retryWaitFunc = retryWait
+
+// retryWait takes the caller's context, and the amount of time to wait. It
+// will return nil if the timer fires before or at the same time as the
+// context's deadline. This indicates that the call can be retried.
+func retryWait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// Handle the case where the timer and context deadline end
+		// simultaneously by prioritizing the timer expiration nil value
+		// response.
+		select {
+		case <-timer.C:
+		default:
+			return ctx.Err()
+		}
+	case <-timer.C:
+	}
+
+	return nil
+}
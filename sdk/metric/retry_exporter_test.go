@@ -0,0 +1,205 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestNewRetryConfig(t *testing.T) {
+	conf := newRetryConfig(nil)
+	assert.Equal(t, defaultRetryInitialInterval, conf.initialInterval)
+	assert.Equal(t, defaultRetryMaxInterval, conf.maxInterval)
+	assert.Equal(t, defaultRetryMaxElapsedTime, conf.maxElapsedTime)
+	assert.Equal(t, 0, conf.bufferSize)
+	assert.True(t, conf.retryable(assert.AnError))
+	assert.False(t, conf.retryable(ErrExporterShutdown))
+
+	conf = newRetryConfig([]RetryOption{
+		WithRetryInitialInterval(time.Second),
+		WithRetryMaxInterval(2 * time.Second),
+		WithRetryMaxElapsedTime(3 * time.Second),
+		WithRetryBufferSize(4),
+		WithRetryable(func(error) bool { return false }),
+	})
+	assert.Equal(t, time.Second, conf.initialInterval)
+	assert.Equal(t, 2*time.Second, conf.maxInterval)
+	assert.Equal(t, 3*time.Second, conf.maxElapsedTime)
+	assert.Equal(t, 4, conf.bufferSize)
+	assert.False(t, conf.retryable(assert.AnError))
+
+	conf = newRetryConfig([]RetryOption{
+		WithRetryInitialInterval(0),
+		WithRetryMaxInterval(-1),
+		WithRetryMaxElapsedTime(0),
+		WithRetryBufferSize(-1),
+		WithRetryable(nil),
+	})
+	assert.Equal(t, defaultRetryInitialInterval, conf.initialInterval, "invalid value should use default")
+	assert.Equal(t, defaultRetryMaxInterval, conf.maxInterval, "invalid value should use default")
+	assert.Equal(t, defaultRetryMaxElapsedTime, conf.maxElapsedTime, "invalid value should use default")
+	assert.Equal(t, 0, conf.bufferSize, "invalid value should use default")
+	assert.True(t, conf.retryable(assert.AnError), "invalid value should use default")
+}
+
+// recordRetryWait overrides the retry wait function to not wait and returns
+// the delays it is called with.
+func recordRetryWait(t *testing.T, err error) *[]time.Duration {
+	t.Helper()
+
+	origWait, origRand := retryWaitFunc, randFloat64
+	t.Cleanup(func() { retryWaitFunc, randFloat64 = origWait, origRand })
+
+	// No jitter.
+	randFloat64 = func() float64 { return 0.5 }
+	var delays []time.Duration
+	retryWaitFunc = func(_ context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return err
+	}
+	return &delays
+}
+
+// failingExporter returns an Exporter failing with err the first n exports
+// and records the name of the first metric of all exported metric data.
+func failingExporter(n int, err error) (Exporter, *[]string) {
+	var exported []string
+	return &fnExporter{
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			exported = append(exported, rm.ScopeMetrics[0].Metrics[0].Name)
+			if n > 0 {
+				n--
+				return err
+			}
+			return nil
+		},
+	}, &exported
+}
+
+func namedResourceMetrics(name string) *metricdata.ResourceMetrics {
+	return &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{
+		Scope:   instrumentation.Scope{Name: "retry"},
+		Metrics: []metricdata.Metrics{{Name: name}},
+	}}}
+}
+
+func TestRetryExporterRetries(t *testing.T) {
+	delays := recordRetryWait(t, nil)
+	exp, exported := failingExporter(3, assert.AnError)
+	e := NewRetryExporter(exp,
+		WithRetryInitialInterval(time.Second),
+		WithRetryMaxInterval(2*time.Second),
+	)
+
+	require.NoError(t, e.Export(context.Background(), namedResourceMetrics("a")))
+	assert.Equal(t, []string{"a", "a", "a", "a"}, *exported)
+	assert.Equal(t, []time.Duration{time.Second, 1500 * time.Millisecond, 2 * time.Second}, *delays)
+}
+
+func TestRetryExporterMaxElapsedTime(t *testing.T) {
+	recordRetryWait(t, nil)
+	exp, exported := failingExporter(1, assert.AnError)
+	e := NewRetryExporter(exp, WithRetryMaxElapsedTime(time.Millisecond))
+
+	err := e.Export(context.Background(), namedResourceMetrics("a"))
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, []string{"a"}, *exported)
+}
+
+func TestRetryExporterNotRetryable(t *testing.T) {
+	delays := recordRetryWait(t, nil)
+	exp, exported := failingExporter(1, ErrExporterShutdown)
+	e := NewRetryExporter(exp)
+
+	err := e.Export(context.Background(), namedResourceMetrics("a"))
+	assert.ErrorIs(t, err, ErrExporterShutdown)
+	assert.Equal(t, []string{"a"}, *exported)
+	assert.Empty(t, *delays)
+}
+
+func TestRetryExporterContextDone(t *testing.T) {
+	recordRetryWait(t, context.Canceled)
+	exp, _ := failingExporter(1, assert.AnError)
+	e := NewRetryExporter(exp)
+
+	err := e.Export(context.Background(), namedResourceMetrics("a"))
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRetryExporterBuffer(t *testing.T) {
+	recordRetryWait(t, nil)
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+	eh := newChErrorHandler()
+	otel.SetErrorHandler(eh)
+
+	exp, exported := failingExporter(3, assert.AnError)
+	e := NewRetryExporter(exp,
+		WithRetryMaxElapsedTime(time.Millisecond),
+		WithRetryBufferSize(1),
+	)
+
+	ctx := context.Background()
+	assert.ErrorIs(t, e.Export(ctx, namedResourceMetrics("a")), assert.AnError)
+	// Retrying "a" from the buffer fails, "b" fails and drops "a".
+	assert.ErrorIs(t, e.Export(ctx, namedResourceMetrics("b")), assert.AnError)
+	assert.ErrorIs(t, <-eh.Err, errRetryBufferFull)
+	require.NoError(t, e.Export(ctx, namedResourceMetrics("c")))
+	assert.Equal(t, []string{"a", "a", "b", "b", "c"}, *exported)
+
+	// The buffer is empty.
+	require.NoError(t, e.ForceFlush(ctx))
+	assert.Len(t, *exported, 5)
+}
+
+func TestRetryExporterFlushesBuffer(t *testing.T) {
+	recordRetryWait(t, nil)
+	exp, exported := failingExporter(1, assert.AnError)
+	e := NewRetryExporter(exp,
+		WithRetryMaxElapsedTime(time.Millisecond),
+		WithRetryBufferSize(1),
+	)
+
+	ctx := context.Background()
+	assert.ErrorIs(t, e.Export(ctx, namedResourceMetrics("a")), assert.AnError)
+	require.NoError(t, e.ForceFlush(ctx))
+	assert.Equal(t, []string{"a", "a"}, *exported)
+}
+
+func TestRetryExporterShutdown(t *testing.T) {
+	recordRetryWait(t, nil)
+	exp, exported := failingExporter(2, assert.AnError)
+	e := NewRetryExporter(exp,
+		WithRetryMaxElapsedTime(time.Millisecond),
+		WithRetryBufferSize(1),
+	)
+
+	ctx := context.Background()
+	assert.ErrorIs(t, e.Export(ctx, namedResourceMetrics("a")), assert.AnError)
+	// The buffered metric data fails again and is dropped.
+	assert.ErrorIs(t, e.Shutdown(ctx), assert.AnError)
+	require.NoError(t, e.ForceFlush(ctx))
+	assert.Equal(t, []string{"a", "a"}, *exported)
+}
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// errVersionRange is returned for an invalid semantic version range.
+var errVersionRange = errors.New("invalid version range")
+
+// isVersionRange returns if s is a semantic version range instead of a
+// version or wildcard pattern.
+func isVersionRange(s string) bool {
+	return strings.ContainsAny(s[:1], "<>=!")
+}
+
+// semver is a parsed semantic version.
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string
+}
+
+// parseSemver parses s as a semantic version. A "v" prefix is allowed, the
+// minor and patch numbers may be omitted, and build metadata is ignored.
+func parseSemver(s string) (semver, bool) {
+	var v semver
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		if i == len(s)-1 {
+			return v, false
+		}
+		v.pre = strings.Split(s[i+1:], ".")
+		s = s[:i]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return v, false
+	}
+	nums := [3]*uint64{&v.major, &v.minor, &v.patch}
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return v, false
+		}
+		*nums[i] = n
+	}
+	return v, true
+}
+
+// compare returns -1, 0, or 1 if v is less than, equal to, or greater than
+// other according to semantic version precedence.
+func (v semver) compare(other semver) int {
+	if c := compareUint(v.major, other.major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.minor, other.minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.patch, other.patch); c != 0 {
+		return c
+	}
+
+	// A pre-release version has lower precedence than the normal version.
+	switch {
+	case len(v.pre) == 0 && len(other.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(other.pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.pre) && i < len(other.pre); i++ {
+		if c := comparePrerelease(v.pre[i], other.pre[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.pre)), uint64(len(other.pre)))
+}
+
+// comparePrerelease compares the pre-release identifiers a and b. Numeric
+// identifiers are compared numerically and have lower precedence than
+// alphanumeric identifiers, which are compared lexically.
+func comparePrerelease(a, b string) int {
+	aN, aErr := strconv.ParseUint(a, 10, 64)
+	bN, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(aN, bN)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// versionRange is a set of comparisons a semantic version needs to satisfy.
+type versionRange []func(semver) bool
+
+// parseVersionRange parses s as a comma separated list of comparisons that a
+// version needs to satisfy. Each comparison is one of the operators "=",
+// "!=", "<", "<=", ">", or ">=" followed by a semantic version (e.g.
+// ">=0.50.0, <1"). A version without an operator needs to be equal.
+func parseVersionRange(s string) (versionRange, error) {
+	var r versionRange
+	for _, c := range strings.Split(s, ",") {
+		c = strings.TrimSpace(c)
+		v := strings.TrimLeft(c, "<>=!")
+		op := c[:len(c)-len(v)]
+		bound, ok := parseSemver(strings.TrimSpace(v))
+		if !ok {
+			return nil, fmt.Errorf("%w: %q: invalid version %q", errVersionRange, s, v)
+		}
+
+		var cmp func(int) bool
+		switch op {
+		case "", "=":
+			cmp = func(c int) bool { return c == 0 }
+		case "!=":
+			cmp = func(c int) bool { return c != 0 }
+		case "<":
+			cmp = func(c int) bool { return c < 0 }
+		case "<=":
+			cmp = func(c int) bool { return c <= 0 }
+		case ">":
+			cmp = func(c int) bool { return c > 0 }
+		case ">=":
+			cmp = func(c int) bool { return c >= 0 }
+		default:
+			return nil, fmt.Errorf("%w: %q: unknown operator %q", errVersionRange, s, op)
+		}
+		r = append(r, func(v semver) bool { return cmp(v.compare(bound)) })
+	}
+	return r, nil
+}
+
+// matches returns if version is a semantic version that satisfies all
+// comparisons of r.
+func (r versionRange) matches(version string) bool {
+	v, ok := parseSemver(version)
+	if !ok {
+		return false
+	}
+	for _, f := range r {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemverCompare(t *testing.T) {
+	// Ordered by increasing precedence.
+	versions := []string{
+		"0.9",
+		"v1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"v1.2",
+		"1.10.0",
+		"2",
+	}
+	for i := 0; i < len(versions); i++ {
+		a, ok := parseSemver(versions[i])
+		require.Truef(t, ok, "failed to parse %s", versions[i])
+		assert.Equalf(t, 0, a.compare(a), "%s != %s", versions[i], versions[i])
+		for j := i + 1; j < len(versions); j++ {
+			b, ok := parseSemver(versions[j])
+			require.Truef(t, ok, "failed to parse %s", versions[j])
+			assert.Equalf(t, -1, a.compare(b), "%s >= %s", versions[i], versions[j])
+			assert.Equalf(t, 1, b.compare(a), "%s <= %s", versions[j], versions[i])
+		}
+	}
+
+	a, _ := parseSemver("1.0.0+build.1")
+	b, _ := parseSemver("v1.0.0")
+	assert.Equal(t, 0, a.compare(b), "build metadata not ignored")
+}
+
+func TestParseSemverInvalid(t *testing.T) {
+	for _, v := range []string{"", "v", "latest", "1.x", "1.2.3.4", "-1.0.0", "1.0.0-"} {
+		_, ok := parseSemver(v)
+		assert.Falsef(t, ok, "parsed invalid version %q", v)
+	}
+}
+
+func TestVersionRange(t *testing.T) {
+	tests := []struct {
+		rng      string
+		match    []string
+		notMatch []string
+	}{
+		{
+			rng:      "=1.2.3",
+			match:    []string{"1.2.3", "v1.2.3"},
+			notMatch: []string{"1.2.4", "1.2.3-rc.1"},
+		},
+		{
+			rng:      "!=1.2.3",
+			match:    []string{"1.2.4", "1.2.3-rc.1"},
+			notMatch: []string{"1.2.3", "dev"},
+		},
+		{
+			rng:      ">=0.50.0",
+			match:    []string{"0.50.0", "0.51.0", "1.0.0"},
+			notMatch: []string{"0.49.0", "0.50.0-rc.1"},
+		},
+		{
+			rng:      ">0.50",
+			match:    []string{"0.50.1", "1.0.0"},
+			notMatch: []string{"0.50.0", "0.49.0"},
+		},
+		{
+			rng:      "<=1",
+			match:    []string{"1.0.0", "0.1.0"},
+			notMatch: []string{"1.0.1"},
+		},
+		{
+			rng:      ">= 1.0.0, < 2.0.0, != 1.5.0",
+			match:    []string{"1.0.0", "1.4.9", "1.5.1"},
+			notMatch: []string{"0.9.0", "1.5.0", "2.0.0"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rng, func(t *testing.T) {
+			require.True(t, isVersionRange(test.rng))
+			r, err := parseVersionRange(test.rng)
+			require.NoError(t, err)
+			for _, v := range test.match {
+				assert.Truef(t, r.matches(v), "%s does not match %s", test.rng, v)
+			}
+			for _, v := range test.notMatch {
+				assert.Falsef(t, r.matches(v), "%s matches %s", test.rng, v)
+			}
+		})
+	}
+}
+
+func TestParseVersionRangeInvalid(t *testing.T) {
+	for _, rng := range []string{">=", ">=1.0,", "=>1.0", "<>1.0", ">=1.0, <two"} {
+		_, err := parseVersionRange(rng)
+		assert.ErrorIsf(t, err, errVersionRange, "range %q", rng)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"regexp"
 	"strings"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 )
 
@@ -47,6 +48,16 @@ type View func(Instrument) (Stream, bool)
 // recognized as matching exactly one character. For example, a pattern of "*"
 // matches all instrument names.
 //
+// The Name, Version, and SchemaURL fields of the criteria Scope support the
+// same wildcard pattern matching. Additionally, if the Version field of the
+// criteria Scope starts with a comparison operator it is a semantic version
+// range: a comma separated list of comparisons using the "=", "!=", "<",
+// "<=", ">", or ">=" operators that all need to be satisfied. For example, a
+// Scope with a Name of "go.opentelemetry.io/contrib/*/otelhttp" and a Version
+// of ">=0.50.0" matches all instruments created by otelhttp version 0.50.0 or
+// later. Versions may have a "v" prefix and omit the minor and patch numbers.
+// Scope versions that are not semantic versions never match a range.
+//
 // The Stream mask only applies updates for non-zero-value fields. By default,
 // the Instrument the View matches against will be use for the Name,
 // Description, and Unit of the returned Stream and no Aggregation or
@@ -62,30 +73,44 @@ func NewView(criteria Instrument, mask Stream) View {
 		return emptyView
 	}
 
-	var matchFunc func(Instrument) bool
-	if strings.ContainsAny(criteria.Name, "*?") {
-		if mask.Name != "" {
-			global.Error(
-				errMultiInst, "dropping view",
-				"criteria", criteria,
-				"mask", mask,
-			)
-			return emptyView
-		}
+	nameWildcard := strings.ContainsAny(criteria.Name, "*?")
+	if nameWildcard && mask.Name != "" {
+		global.Error(
+			errMultiInst, "dropping view",
+			"criteria", criteria,
+			"mask", mask,
+		)
+		return emptyView
+	}
 
+	matchScope, err := scopeMatcher(criteria)
+	if err != nil {
+		global.Error(
+			err, "dropping view",
+			"criteria", criteria,
+			"mask", mask,
+		)
+		return emptyView
+	}
+
+	var matchFunc func(Instrument) bool
+	if nameWildcard || matchScope != nil {
 		// Handle branching here in NewView instead of criteria.matches so
 		// criteria.matches remains inlinable for the simple case.
-		pattern := regexp.QuoteMeta(criteria.Name)
-		pattern = "^" + pattern + "$"
-		pattern = strings.ReplaceAll(pattern, `\?`, ".")
-		pattern = strings.ReplaceAll(pattern, `\*`, ".*")
-		re := regexp.MustCompile(pattern)
+		matchName := criteria.matchesName
+		if nameWildcard {
+			re := regexp.MustCompile("^" + wildcardPattern(criteria.Name) + "$")
+			matchName = func(i Instrument) bool { return re.MatchString(i.Name) }
+		}
+		if matchScope == nil {
+			matchScope = criteria.matchesScope
+		}
 		matchFunc = func(i Instrument) bool {
-			return re.MatchString(i.Name) &&
+			return matchName(i) &&
 				criteria.matchesDescription(i) &&
 				criteria.matchesKind(i) &&
 				criteria.matchesUnit(i) &&
-				criteria.matchesScope(i)
+				matchScope(i)
 		}
 	} else {
 		matchFunc = criteria.matches
@@ -107,17 +132,123 @@ func NewView(criteria Instrument, mask Stream) View {
 	return func(i Instrument) (Stream, bool) {
 		if matchFunc(i) {
 			return Stream{
-				Name:            nonZero(mask.Name, i.Name),
-				Description:     nonZero(mask.Description, i.Description),
-				Unit:            nonZero(mask.Unit, i.Unit),
-				Aggregation:     agg,
-				AttributeFilter: mask.AttributeFilter,
+				Name:              nonZero(mask.Name, i.Name),
+				Description:       nonZero(mask.Description, i.Description),
+				Unit:              nonZero(mask.Unit, i.Unit),
+				Aggregation:       agg,
+				AttributeFilter:   mask.AttributeFilter,
+				MeasurementFilter: mask.MeasurementFilter,
+				AggregationLimit:  mask.AggregationLimit,
+				Scale:             mask.Scale,
+				Temporality:       mask.Temporality,
 			}, true
 		}
 		return Stream{}, false
 	}
 }
 
+// wildcardPattern returns the regular expression matching the same strings
+// as the wildcard pattern p. The "*" wildcard matches zero or more
+// characters and "?" matches exactly one character.
+func wildcardPattern(p string) string {
+	pattern := regexp.QuoteMeta(p)
+	pattern = strings.ReplaceAll(pattern, `\?`, ".")
+	return strings.ReplaceAll(pattern, `\*`, ".*")
+}
+
+// scopeMatcher returns a function matching the Scope of an Instrument against
+// the Scope of criteria if any of its fields is a wildcard pattern or version
+// range. Otherwise, nil is returned and the Scope needs to be matched exactly.
+// An error is returned if the Version is an invalid version range.
+func scopeMatcher(criteria Instrument) (func(Instrument) bool, error) {
+	s := criteria.Scope
+	if !strings.ContainsAny(s.Name+s.Version+s.SchemaURL, "*?") &&
+		(s.Version == "" || !isVersionRange(s.Version)) {
+		return nil, nil
+	}
+
+	matchName := stringMatcher(s.Name)
+	matchSchemaURL := stringMatcher(s.SchemaURL)
+	matchVersion := stringMatcher(s.Version)
+	if s.Version != "" && isVersionRange(s.Version) {
+		r, err := parseVersionRange(s.Version)
+		if err != nil {
+			return nil, err
+		}
+		matchVersion = r.matches
+	}
+	return func(i Instrument) bool {
+		return matchName(i.Scope.Name) &&
+			matchVersion(i.Scope.Version) &&
+			matchSchemaURL(i.Scope.SchemaURL)
+	}, nil
+}
+
+// stringMatcher returns a function matching all strings if pattern is empty,
+// strings matching pattern if it is a wildcard pattern, and otherwise only
+// pattern itself.
+func stringMatcher(pattern string) func(string) bool {
+	switch {
+	case pattern == "":
+		return func(string) bool { return true }
+	case strings.ContainsAny(pattern, "*?"):
+		re := regexp.MustCompile("^" + wildcardPattern(pattern) + "$")
+		return re.MatchString
+	}
+	return func(s string) bool { return s == pattern }
+}
+
+// NewAllowKeyPatternsFilter returns an attribute Filter that only allows
+// attributes with keys matching one of patterns. It is the same as
+// NewAllowKeysFilter from "go.opentelemetry.io/otel/attribute", but also
+// allows wildcard patterns. It is intended to be used as the AttributeFilter
+// of a Stream.
+//
+// The patterns support the same wildcard pattern matching as the Name of the
+// Instrument criteria of NewView. The "*" wildcard matches zero or more
+// characters, and "?" matches exactly one character. For example, a pattern
+// of "http.*" allows all attributes with keys starting with "http.".
+//
+// If no patterns are provided, all attributes are filtered out.
+func NewAllowKeyPatternsFilter(patterns ...string) attribute.Filter {
+	exact := make(map[attribute.Key]struct{})
+	var wildcards []string
+	for _, p := range patterns {
+		if strings.ContainsAny(p, "*?") {
+			wildcards = append(wildcards, wildcardPattern(p))
+			continue
+		}
+		exact[attribute.Key(p)] = struct{}{}
+	}
+
+	var re *regexp.Regexp
+	if len(wildcards) > 0 {
+		re = regexp.MustCompile("^(?:" + strings.Join(wildcards, "|") + ")$")
+	}
+	return func(kv attribute.KeyValue) bool {
+		if _, ok := exact[kv.Key]; ok {
+			return true
+		}
+		return re != nil && re.MatchString(string(kv.Key))
+	}
+}
+
+// NewAllowKeyRegexpFilter returns an attribute Filter that only allows
+// attributes with keys matched by one of res. It is intended to be used as
+// the AttributeFilter of a Stream.
+//
+// If no regular expressions are provided, all attributes are filtered out.
+func NewAllowKeyRegexpFilter(res ...*regexp.Regexp) attribute.Filter {
+	return func(kv attribute.KeyValue) bool {
+		for _, re := range res {
+			if re != nil && re.MatchString(string(kv.Key)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // nonZero returns v if it is non-zero-valued, otherwise alt.
 func nonZero[T comparable](v, alt T) T {
 	var zero T
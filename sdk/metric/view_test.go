@@ -15,6 +15,8 @@
 package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
+	"context"
+	"regexp"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -25,7 +27,10 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 )
 
 var (
@@ -264,6 +269,76 @@ func TestNewViewMatch(t *testing.T) {
 				{Scope: scope("NameMisMatch", "v0.1.0", schemaURL)},
 			},
 		},
+		{
+			name:     "ScopeNameWildcard",
+			criteria: Instrument{Scope: scope("go.opentelemetry.io/contrib/*/otelhttp", "", "")},
+			matches: []Instrument{
+				{Scope: scope("go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp", "", "")},
+			},
+			notMatches: []Instrument{
+				{},
+				{Scope: scope("go.opentelemetry.io/contrib/otelhttp", "", "")},
+				{Scope: scope("go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp/internal", "", "")},
+				completeIP,
+			},
+		},
+		{
+			name:     "ScopeVersionWildcard",
+			criteria: Instrument{Scope: scope("", "v0.1.?", "")},
+			matches: []Instrument{
+				{Scope: scope("", "v0.1.1", "")},
+				completeIP,
+			},
+			notMatches: []Instrument{
+				{},
+				{Scope: scope("", "v0.1.10", "")},
+				{Scope: scope("", "v0.2.0", "")},
+			},
+		},
+		{
+			name:     "ScopeVersionRange",
+			criteria: Instrument{Scope: scope("", ">=0.50, <1", "")},
+			matches: []Instrument{
+				{Scope: scope("", "0.50.0", "")},
+				{Scope: scope("", "v0.52.3", "")},
+				{Scope: scope("", "0.99.0+build.7", "")},
+			},
+			notMatches: []Instrument{
+				{},
+				{Scope: scope("", "0.49.9", "")},
+				{Scope: scope("", "0.50.0-rc.1", "")},
+				{Scope: scope("", "1.0.0", "")},
+				{Scope: scope("", "latest", "")},
+				completeIP,
+			},
+		},
+		{
+			name:     "ScopeSchemaURLWildcard",
+			criteria: Instrument{Scope: scope("", "", "https://opentelemetry.io/schemas/1.*")},
+			matches: []Instrument{
+				{Scope: scope("", "", "https://opentelemetry.io/schemas/1.21.0")},
+				completeIP,
+			},
+			notMatches: []Instrument{
+				{},
+				{Scope: scope("", "", "https://opentelemetry.io/schemas/2.0.0")},
+			},
+		},
+		{
+			name: "ScopeWildcardAndName",
+			criteria: Instrument{
+				Name:  "http.*",
+				Scope: scope("*otelhttp", ">0.49", ""),
+			},
+			matches: []Instrument{
+				{Name: "http.server.duration", Scope: scope("otelhttp", "0.50.0", "")},
+			},
+			notMatches: []Instrument{
+				{Name: "http.server.duration", Scope: scope("otelhttp", "0.49.0", "")},
+				{Name: "rpc.server.duration", Scope: scope("otelhttp", "0.50.0", "")},
+				{Name: "http.server.duration", Scope: scope("otelgrpc", "0.50.0", "")},
+			},
+		},
 		{
 			name:     "Complete",
 			criteria: completeIP,
@@ -402,6 +477,30 @@ func TestNewViewReplace(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Scale",
+			mask: Stream{Unit: "s", Scale: 0.001},
+			want: func(i Instrument) Stream {
+				return Stream{
+					Name:        i.Name,
+					Description: i.Description,
+					Unit:        "s",
+					Scale:       0.001,
+				}
+			},
+		},
+		{
+			name: "Temporality",
+			mask: Stream{Temporality: metricdata.DeltaTemporality},
+			want: func(i Instrument) Stream {
+				return Stream{
+					Name:        i.Name,
+					Description: i.Description,
+					Unit:        i.Unit,
+					Temporality: metricdata.DeltaTemporality,
+				}
+			},
+		},
 		{
 			name: "Complete",
 			mask: Stream{
@@ -447,6 +546,323 @@ func TestNewViewReplace(t *testing.T) {
 	})
 }
 
+func TestNewAllowKeyPatternsFilter(t *testing.T) {
+	filter := NewAllowKeyPatternsFilter("user", "http.*", "net.?")
+
+	tests := []struct {
+		key  attribute.Key
+		want bool
+	}{
+		{"user", true},
+		{"user.id", false},
+		{"http.method", true},
+		{"http.", true},
+		{"http", false},
+		{"xhttp.method", false},
+		{"net.a", true},
+		{"net.ab", false},
+		{"other", false},
+	}
+	for _, test := range tests {
+		got := filter(test.key.String("val"))
+		assert.Equalf(t, test.want, got, "key %q", test.key)
+	}
+
+	none := NewAllowKeyPatternsFilter()
+	assert.False(t, none(attribute.String("user", "val")), "empty allow-list")
+}
+
+func TestNewAllowKeyRegexpFilter(t *testing.T) {
+	filter := NewAllowKeyRegexpFilter(
+		regexp.MustCompile(`^http\.(method|status_code)$`),
+		regexp.MustCompile(`^user$`),
+	)
+	assert.True(t, filter(attribute.String("http.method", "GET")))
+	assert.True(t, filter(attribute.Int("http.status_code", 200)))
+	assert.True(t, filter(attribute.String("user", "alice")))
+	assert.False(t, filter(attribute.String("http.url", "/")))
+
+	none := NewAllowKeyRegexpFilter()
+	assert.False(t, none(attribute.String("user", "alice")), "empty allow-list")
+}
+
+func TestNewViewAllowKeyPatternsFilter(t *testing.T) {
+	rdr := NewManualReader()
+	view := NewView(
+		Instrument{Name: "*"},
+		Stream{AttributeFilter: NewAllowKeyPatternsFilter("http.*")},
+	)
+	mp := NewMeterProvider(WithReader(rdr), WithView(view))
+	ctr, err := mp.Meter("TestNewViewNewAllowKeyPatternsFilter").Int64Counter("requests")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ctr.Add(ctx, 1, api.WithAttributes(
+		attribute.String("http.method", "GET"),
+		attribute.String("user", "alice"),
+	))
+	ctr.Add(ctx, 1, api.WithAttributes(
+		attribute.String("http.method", "GET"),
+		attribute.String("user", "bob"),
+	))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, attribute.NewSet(attribute.String("http.method", "GET")), sum.DataPoints[0].Attributes)
+	assert.Equal(t, int64(2), sum.DataPoints[0].Value)
+}
+
+func TestNewViewUnitConversion(t *testing.T) {
+	rdr := NewManualReader()
+	view := NewView(
+		Instrument{Unit: "ms"},
+		Stream{
+			Unit:  "s",
+			Scale: 0.001,
+			Aggregation: AggregationExplicitBucketHistogram{
+				Boundaries: []float64{0.1, 1},
+			},
+		},
+	)
+	mp := NewMeterProvider(WithReader(rdr), WithView(view))
+	hist, err := mp.Meter("TestNewViewUnitConversion").Float64Histogram(
+		"duration",
+		api.WithUnit("ms"),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	hist.Record(ctx, 50)
+	hist.Record(ctx, 500)
+	hist.Record(ctx, 5000)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	m := rm.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "s", m.Unit)
+	h, ok := m.Data.(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, h.DataPoints, 1)
+	assert.Equal(t, []uint64{1, 1, 1}, h.DataPoints[0].BucketCounts)
+	assert.InDelta(t, 5.55, h.DataPoints[0].Sum, 1e-9)
+}
+
+func TestNewViewScaleInt64(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithView(
+			NewView(Instrument{Name: "truncated"}, Stream{Unit: "s", Scale: 0.001}),
+			NewView(Instrument{Name: "integral"}, Stream{Unit: "ms", Scale: 1000}),
+		),
+	)
+	meter := mp.Meter("TestNewViewScaleInt64")
+	_, err := meter.Int64Counter("truncated", api.WithUnit("ms"))
+	assert.ErrorContains(t, err, errNonIntegralScale.Error())
+	ctr, err := meter.Int64Counter("integral", api.WithUnit("s"))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ctr.Add(ctx, 2)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	m := rm.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "integral", m.Name)
+	sum, ok := m.Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(2000), sum.DataPoints[0].Value)
+}
+
+func TestNewViewScaleDistinctStreams(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithView(
+			NewView(Instrument{Name: "ctr"}, Stream{Scale: 2}),
+			NewView(Instrument{Name: "ctr"}, Stream{Scale: 3}),
+		),
+	)
+	ctr, err := mp.Meter("TestNewViewScaleDistinctStreams").Float64Counter("ctr")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ctr.Add(ctx, 1)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	var got []float64
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		sum, ok := m.Data.(metricdata.Sum[float64])
+		require.True(t, ok)
+		require.Len(t, sum.DataPoints, 1)
+		got = append(got, sum.DataPoints[0].Value)
+	}
+	assert.ElementsMatch(t, []float64{2, 3}, got, "streams differing only in Scale share an aggregator")
+}
+
+func TestNewViewTemporality(t *testing.T) {
+	// The reader uses cumulative temporality for all instrument kinds.
+	rdr := NewManualReader()
+	view := NewView(
+		Instrument{Name: "delta"},
+		Stream{Temporality: metricdata.DeltaTemporality},
+	)
+	mp := NewMeterProvider(WithReader(rdr), WithView(view))
+	meter := mp.Meter("TestNewViewTemporality")
+	delta, err := meter.Int64Counter("delta")
+	require.NoError(t, err)
+	cumulative, err := meter.Int64Counter("cumulative")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	want := map[string]metricdata.Sum[int64]{
+		"delta": {
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+		},
+		"cumulative": {
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 2}},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		delta.Add(ctx, 1)
+		cumulative.Add(ctx, 1)
+	}
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	delta.Add(ctx, 1)
+	cumulative.Add(ctx, 0)
+	require.NoError(t, rdr.Collect(ctx, &rm))
+
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, len(want))
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metricdatatest.AssertAggregationsEqual(t, want[m.Name], m.Data, metricdatatest.IgnoreTimestamp())
+	}
+}
+
+func TestNewViewMeasurementFilter(t *testing.T) {
+	rdr := NewManualReader()
+	view := NewView(
+		Instrument{Name: "requests"},
+		Stream{
+			MeasurementFilter: func(s attribute.Set) bool {
+				v, _ := s.Value("http.route")
+				return v.AsString() != "/healthz"
+			},
+			AttributeFilter: NewAllowKeyPatternsFilter("http.method"),
+		},
+	)
+	mp := NewMeterProvider(WithReader(rdr), WithView(view))
+	ctr, err := mp.Meter("TestNewViewMeasurementFilter").Int64Counter("requests")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, route := range []string{"/", "/healthz", "/users", "/healthz"} {
+		ctr.Add(ctx, 1, api.WithAttributes(
+			attribute.String("http.method", "GET"),
+			attribute.String("http.route", route),
+		))
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, attribute.NewSet(attribute.String("http.method", "GET")), sum.DataPoints[0].Attributes)
+	assert.Equal(t, int64(2), sum.DataPoints[0].Value)
+}
+
+func TestNewViewSummaryAggregation(t *testing.T) {
+	rdr := NewManualReader()
+	view := NewView(
+		Instrument{Name: "latency"},
+		Stream{Aggregation: AggregationSummary{}},
+	)
+	mp := NewMeterProvider(WithReader(rdr), WithView(view))
+	hist, err := mp.Meter("TestNewViewSummaryAggregation").Int64Histogram("latency")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, v := range []int64{5, 1, 9} {
+		hist.Record(ctx, v)
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Summary[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints: []metricdata.SummaryDataPoint[int64]{{
+			Count: 3,
+			Min:   metricdata.NewExtrema[int64](1),
+			Max:   metricdata.NewExtrema[int64](9),
+			Sum:   15,
+		}},
+	}, rm.ScopeMetrics[0].Metrics[0].Data, metricdatatest.IgnoreTimestamp())
+}
+
+func TestNewViewExponentialHistogramPerInstrument(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithView(NewView(
+			Instrument{Name: "fine"},
+			Stream{Aggregation: AggregationBase2ExponentialHistogram{
+				MaxSize:  160,
+				MaxScale: 20,
+			}},
+		)),
+		WithView(NewView(
+			Instrument{Name: "coarse"},
+			Stream{Aggregation: AggregationBase2ExponentialHistogram{
+				MaxSize:  160,
+				MaxScale: 2,
+			}},
+		)),
+	)
+	meter := mp.Meter("TestNewViewExponentialHistogramPerInstrument")
+	fine, err := meter.Float64Histogram("fine")
+	require.NoError(t, err)
+	coarse, err := meter.Float64Histogram("coarse")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	fine.Record(ctx, 3)
+	coarse.Record(ctx, 3)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	want := map[string]int32{"fine": 20, "coarse": 2}
+	require.Len(t, rm.ScopeMetrics[0].Metrics, len(want))
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		data, ok := m.Data.(metricdata.ExponentialHistogram[float64])
+		require.Truef(t, ok, "%s: unexpected data type %T", m.Name, m.Data)
+		require.Len(t, data.DataPoints, 1)
+		assert.Equal(t, want[m.Name], data.DataPoints[0].Scale, m.Name)
+	}
+}
+
 type badAgg struct {
 	e error
 }
@@ -491,3 +907,16 @@ func TestNewViewMultiInstMatchErrorLogged(t *testing.T) {
 	})
 	assert.Contains(t, got, errMultiInst.Error())
 }
+
+func TestNewViewInvalidVersionRangeErrorLogged(t *testing.T) {
+	var got string
+	otel.SetLogger(funcr.New(func(_, args string) {
+		got = args
+	}, funcr.Options{Verbosity: 6}))
+
+	v := NewView(Instrument{Scope: scope("", ">=one", "")}, Stream{})
+	assert.Contains(t, got, errVersionRange.Error())
+
+	_, match := v(Instrument{Scope: scope("", "1.0.0", "")})
+	assert.False(t, match, "invalid view matched")
+}